@@ -6,13 +6,13 @@ import (
 	"time"
 
 	"github.com/0xa1-red/empires-of-avalon/instrumentation/metrics"
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
 	"github.com/0xa1-red/empires-of-avalon/protobuf"
-	intnats "github.com/0xa1-red/empires-of-avalon/transport/nats"
+	"github.com/0xa1-red/empires-of-avalon/transport"
 	pactor "github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/cluster"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/google/uuid"
-	"github.com/nats-io/nats.go"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"golang.org/x/exp/slog"
@@ -134,12 +134,18 @@ func (g *Grain) heartbeat(a actor) {
 }
 
 type Grain struct {
-	ctx cluster.GrainContext
+	ctx   cluster.GrainContext
+	clock clock.Clock
 
 	registry     *registry
-	subscription *nats.Subscription
+	subscription transport.Subscription
 	activeActors metric.Int64UpDownCounter
-	cleanupTimer *time.Ticker
+	cleanupTimer clock.Ticker
+}
+
+// New creates the admin grain that takes its time from the given clock
+func New(clk clock.Clock) *Grain {
+	return &Grain{clock: clk} // nolint:exhaustruct
 }
 
 func (g *Grain) Init(ctx cluster.GrainContext) {
@@ -171,12 +177,12 @@ func (g *Grain) Start(_ *protobuf.Empty, ctx cluster.GrainContext) (*protobuf.Em
 		Timers:      make(map[string]actor),
 	}
 
-	transport, err := intnats.GetConnection()
+	conn, err := transport.Get()
 	if err != nil {
 		return nil, err
 	}
 
-	sub, err := transport.Subscribe(AdminSubject, g.messageCallback)
+	sub, err := conn.Subscribe(AdminSubject, g.messageCallback)
 	if err != nil {
 		return nil, err
 	}
@@ -185,17 +191,17 @@ func (g *Grain) Start(_ *protobuf.Empty, ctx cluster.GrainContext) (*protobuf.Em
 
 	g.subscription = sub
 
-	g.cleanupTimer = time.NewTicker(30 * time.Second)
+	g.cleanupTimer = g.clock.NewTicker(30 * time.Second)
 	go func() {
-		for range g.cleanupTimer.C {
+		for range g.cleanupTimer.C() {
 			for i := range g.registry.Inventories {
 				actor := g.registry.Inventories[i]
-				checkHeartbeat(&actor)
+				checkHeartbeat(&actor, g.clock.Now())
 			}
 
 			for i := range g.registry.Timers {
 				actor := g.registry.Timers[i]
-				checkHeartbeat(&actor)
+				checkHeartbeat(&actor, g.clock.Now())
 			}
 		}
 	}()
@@ -203,8 +209,8 @@ func (g *Grain) Start(_ *protobuf.Empty, ctx cluster.GrainContext) (*protobuf.Em
 	return nil, nil
 }
 
-func checkHeartbeat(a *actor) {
-	if a.LastSeen.Before(time.Now().Add(-1 * time.Minute)) {
+func checkHeartbeat(a *actor, now time.Time) {
+	if a.LastSeen.Before(now.Add(-1 * time.Minute)) {
 		a.Tolerations += 1
 
 		slog.Warn(
@@ -278,7 +284,7 @@ func (g *Grain) Describe(req *protobuf.DescribeAdminRequest, ctx cluster.GrainCo
 	if err != nil {
 		return &protobuf.DescribeAdminResponse{
 			Admin:     nil,
-			Timestamp: timestamppb.Now(),
+			Timestamp: timestamppb.New(g.clock.Now()),
 			Status:    protobuf.Status_Error,
 			Error:     err.Error(),
 		}, nil
@@ -286,7 +292,7 @@ func (g *Grain) Describe(req *protobuf.DescribeAdminRequest, ctx cluster.GrainCo
 
 	return &protobuf.DescribeAdminResponse{
 		Admin:     adminStruct,
-		Timestamp: timestamppb.Now(),
+		Timestamp: timestamppb.New(g.clock.Now()),
 		Status:    protobuf.Status_OK,
 		Error:     "",
 	}, nil
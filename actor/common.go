@@ -3,14 +3,14 @@ package actor
 import (
 	"github.com/0xa1-red/empires-of-avalon/actor/admin"
 	"github.com/0xa1-red/empires-of-avalon/protobuf"
-	"github.com/0xa1-red/empires-of-avalon/transport/nats"
+	"github.com/0xa1-red/empires-of-avalon/transport"
 	"golang.org/x/exp/slog"
 )
 
 func SendUpdate(update *protobuf.GrainUpdate) error {
-	conn, err := nats.GetConnection()
+	conn, err := transport.Get()
 	if err != nil {
-		slog.Error("failed to get NATS connection", err)
+		slog.Error("failed to get transport", err)
 		return err
 	}
 
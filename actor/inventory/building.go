@@ -20,6 +20,33 @@ type Building struct {
 	Completion        time.Time
 	ReservedResources []ReservedResource
 	Timers            *TimerRegister
+	TimerID           uuid.UUID
+	Paused            bool
+	Level             int
+	Upgrade           *Upgrade
+	Settled           map[string]time.Time
+}
+
+// Upgrade is an upgrade of a completed building waiting for its timer
+type Upgrade struct {
+	Level             int
+	TimerID           uuid.UUID
+	Completion        time.Time
+	ReservedResources []ReservedResource
+}
+
+// CurrentLevel returns the level of the building. Buildings persisted before levels existed are level 1.
+func (b Building) CurrentLevel() int {
+	if b.Level < 1 {
+		return 1
+	}
+
+	return b.Level
+}
+
+// hasTimers reports whether the building has production timers running
+func (b Building) hasTimers() bool {
+	return b.Timers != nil && len(b.Timers.Generators)+len(b.Timers.Transformers) > 0
 }
 
 func (b Building) Describe() *structpb.Value {
@@ -32,8 +59,10 @@ func (b Building) Describe() *structpb.Value {
 
 	if b.State == protobuf.BuildingState_BuildingStateQueued {
 		fields = map[string]*structpb.Value{
+			"id":         structpb.NewStringValue(b.ID.String()),
 			"state":      structpb.NewStringValue(b.State.String()),
 			"completion": structpb.NewStringValue(finish),
+			"waiting":    structpb.NewBoolValue(b.TimerID == uuid.Nil),
 		}
 	} else {
 		generators := make([]interface{}, 0)
@@ -59,14 +88,25 @@ func (b Building) Describe() *structpb.Value {
 		fields = map[string]*structpb.Value{
 			"id":              structpb.NewStringValue(b.ID.String()),
 			"state":           structpb.NewStringValue(b.State.String()),
+			"level":           structpb.NewNumberValue(float64(b.CurrentLevel())),
 			"workers_max":     structpb.NewNumberValue(float64(b.WorkersMaximum)),
 			"workers_current": structpb.NewNumberValue(float64(b.WorkersCurrent)),
+			"paused":          structpb.NewBoolValue(b.Paused),
 			"completion":      structpb.NewStringValue(finish),
 			"generators":      structpb.NewListValue(genpb),
 			"transformers":    structpb.NewListValue(transpb),
 		}
 	}
 
+	if b.Upgrade != nil {
+		fields["upgrade"] = structpb.NewStructValue(&structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"level":      structpb.NewNumberValue(float64(b.Upgrade.Level)),
+				"completion": structpb.NewStringValue(b.Upgrade.Completion.Format(time.RFC3339)),
+			},
+		})
+	}
+
 	return structpb.NewStructValue(&structpb.Struct{
 		Fields: fields,
 	})
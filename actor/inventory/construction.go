@@ -0,0 +1,429 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// queueRetryMin and queueRetryMax bound the backoff of processing the queue again after a
+	// construction timer failed to start
+	queueRetryMin = time.Second
+	queueRetryMax = time.Minute
+
+	// maxBuildAmount caps the buildings of a single request if inventory.max_build_amount doesn't
+	maxBuildAmount = 1000
+)
+
+// retryQueue is sent by the grain to itself to process the queue again once the backoff passed
+type retryQueue struct{}
+
+// ConstructionOrder points at a queued building waiting for a free construction slot
+type ConstructionOrder struct {
+	BlueprintID uuid.UUID
+	BuildingID  uuid.UUID
+}
+
+// buildAmount clamps the number of buildings requested at once between 1 and the configured maximum
+func buildAmount(requested int64) int {
+	maximum := viper.GetInt64(config.Inventory_Max_Build_Amount)
+	if maximum <= 0 {
+		maximum = maxBuildAmount
+	}
+
+	return int(min(max(requested, 1), maximum))
+}
+
+// constructionSlots returns the number of buildings that can be under construction at the same time.
+// Every inventory gets the configured base amount, and completed buildings can grant extra slots
+// through the build_slots field of their blueprint.
+func (g *Grain) constructionSlots() int {
+	slots := viper.GetInt(config.Inventory_Build_Slots)
+
+	for _, register := range g.buildings {
+		blueprint, err := registry.GetBuilding(register.Name)
+		if err != nil {
+			slog.Warn("failed to retrieve building blueprint", "name", register.Name)
+			continue
+		}
+
+		slots += blueprint.BuildSlots * len(register.Completed)
+	}
+
+	return slots
+}
+
+// activeConstructions returns the number of buildings currently occupying a construction slot
+func (g *Grain) activeConstructions() int {
+	active := 0
+
+	for _, register := range g.buildings {
+		for _, building := range register.Queue {
+			if building.TimerID != uuid.Nil {
+				active++
+			}
+		}
+	}
+
+	return active
+}
+
+// insufficientResources returns the resources that aren't available in the given amount
+// to pay for the requested number of buildings
+func (g *Grain) insufficientResources(cost []blueprints.ResourceCost, amount int) []string {
+	insufficient := make([]string, 0)
+
+	for _, c := range cost {
+		resource, ok := g.resources[c.Resource]
+		if !ok || resource.Amount < c.Amount*amount {
+			insufficient = append(insufficient, string(c.Resource))
+		}
+	}
+
+	return insufficient
+}
+
+// reserveCost moves the cost of a single building from the available amount to the reserved amount
+func (g *Grain) reserveCost(cost []blueprints.ResourceCost) []ReservedResource {
+	reserved := make([]ReservedResource, 0)
+
+	for _, c := range cost {
+		resource := g.resources[c.Resource]
+
+		resource.mx.Lock()
+		resource.Amount -= c.Amount
+		resource.Reserved += c.Amount
+		resource.mx.Unlock()
+
+		reserved = append(reserved, ReservedResource{
+			Name:      string(c.Resource),
+			Amount:    c.Amount,
+			Permanent: c.Permanent,
+		})
+	}
+
+	return reserved
+}
+
+// releaseReserved clears the reservations of a building. Permanent costs are consumed,
+// temporary ones are returned to the available amount.
+func (g *Grain) releaseReserved(reserved []ReservedResource) {
+	for _, r := range reserved {
+		resource, ok := g.resources[blueprints.ResourceName(r.Name)]
+		if !ok {
+			slog.Warn("reserved resource not found", "resource", r.Name)
+			continue
+		}
+
+		resource.mx.Lock()
+		resource.Reserved -= r.Amount
+
+		if !r.Permanent {
+			resource.Amount += r.Amount
+		}
+		resource.mx.Unlock()
+	}
+}
+
+// enqueueBuilding adds a new building to the construction queue and reserves its cost
+func (g *Grain) enqueueBuilding(blueprint *blueprints.Building) uuid.UUID {
+	buildingID := uuid.New()
+
+	build := Building{
+		ID:                buildingID,
+		BlueprintID:       blueprint.ID,
+		Name:              blueprint.Name,
+		State:             protobuf.BuildingState_BuildingStateQueued,
+		WorkersMaximum:    blueprint.WorkersMaximum,
+		WorkersCurrent:    0,
+		ReservedResources: g.reserveCost(blueprint.Cost),
+		Timers:            NewTimerRegister(),
+		TimerID:           uuid.Nil,
+		Paused:            false,
+		Level:             1,
+		Upgrade:           nil,
+		Settled:           make(map[string]time.Time),
+	}
+
+	register := g.buildings[blueprint.ID]
+
+	register.mx.Lock()
+	register.Queue[buildingID] = build
+	register.mx.Unlock()
+
+	g.queue = append(g.queue, ConstructionOrder{
+		BlueprintID: blueprint.ID,
+		BuildingID:  buildingID,
+	})
+
+	slog.Info("queued building", "name", string(blueprint.Name), "id", buildingID.String(), "position", len(g.queue))
+
+	return buildingID
+}
+
+// processQueue starts the construction of queued buildings in FIFO order as long as there are free slots.
+// A building whose timer couldn't be started stays at the head of the queue to be tried again, buildings
+// that can't be constructed at all are dropped, so they don't hold up the ones behind them.
+func (g *Grain) processQueue(ctx context.Context) {
+	slots := g.constructionSlots()
+
+	for len(g.queue) > 0 && g.activeConstructions() < slots {
+		order := g.queue[0]
+
+		err := g.startConstruction(ctx, order)
+		if errors.As(err, &TimerError{}) { // nolint:exhaustruct
+			slog.Error("failed to start construction", err, "building_id", order.BuildingID.String())
+			g.scheduleQueueRetry()
+
+			return
+		} else if err != nil {
+			slog.Error("dropping queued building", err, "building_id", order.BuildingID.String())
+			g.dropOrder(order)
+
+			continue
+		}
+
+		g.queue = g.queue[1:]
+		g.queueBackoff = 0
+	}
+}
+
+// scheduleQueueRetry processes the queue again after a backoff, which doubles with every failed
+// attempt up to queueRetryMax. Only one retry is pending at a time.
+func (g *Grain) scheduleQueueRetry() {
+	if g.queueRetry != nil {
+		return
+	}
+
+	g.queueBackoff = min(max(2*g.queueBackoff, queueRetryMin), queueRetryMax)
+
+	slog.Debug("retrying construction queue", "backoff", g.queueBackoff.String())
+
+	t := g.clock.NewTimer(g.queueBackoff)
+	stop := make(chan struct{})
+	g.queueRetry = stop
+
+	ctx := g.ctx
+
+	go func() {
+		defer t.Stop()
+
+		select {
+		case <-t.C():
+			ctx.Send(ctx.Self(), &retryQueue{})
+		case <-stop:
+		}
+	}()
+}
+
+// cancelQueueRetry drops the pending retry of the queue
+func (g *Grain) cancelQueueRetry() {
+	if g.queueRetry == nil {
+		return
+	}
+
+	close(g.queueRetry)
+	g.queueRetry = nil
+}
+
+// dropOrder removes a queued building that can't be constructed and refunds its reserved cost in full
+func (g *Grain) dropOrder(order ConstructionOrder) {
+	g.removeOrder(order.BuildingID)
+
+	register, ok := g.buildings[order.BlueprintID]
+	if !ok {
+		return
+	}
+
+	register.mx.Lock()
+	build, ok := register.Queue[order.BuildingID]
+	delete(register.Queue, order.BuildingID)
+	register.mx.Unlock()
+
+	if ok {
+		g.refundReserved(build.ReservedResources, 100)
+	}
+}
+
+func (g *Grain) startConstruction(ctx context.Context, order ConstructionOrder) error {
+	register, ok := g.buildings[order.BlueprintID]
+	if !ok {
+		return fmt.Errorf("building register %s not found", order.BlueprintID.String())
+	}
+
+	register.mx.Lock()
+	defer register.mx.Unlock()
+
+	build, ok := register.Queue[order.BuildingID]
+	if !ok {
+		return fmt.Errorf("queued building %s not found", order.BuildingID.String())
+	}
+
+	blueprint, err := registry.GetBuilding(register.Name)
+	if err != nil {
+		return err
+	}
+
+	timerID, deadline, err := g.startBuildingTimer(ctx, CallbackBuildings, blueprint.BuildTime, map[string]*structpb.Value{
+		KeyId:       structpb.NewStringValue(order.BuildingID.String()),
+		KeyBuilding: structpb.NewStringValue(string(blueprint.Name)),
+		KeyAmount:   structpb.NewNumberValue(1),
+	})
+	if err != nil {
+		return TimerError{Err: err}
+	}
+
+	build.TimerID = timerID
+	build.Completion = deadline
+	register.Queue[order.BuildingID] = build
+
+	return nil
+}
+
+// startBuildingTimer creates a one-off timer reporting back to the given callback once the duration has passed
+func (g *Grain) startBuildingTimer(ctx context.Context, callback, duration string, data map[string]*structpb.Value) (uuid.UUID, time.Time, error) {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, &carrier)
+
+	timerID := uuid.New()
+	res, err := g.timer(timerID).CreateTimer(&protobuf.TimerRequest{
+		TimerID:     timerID.String(),
+		TraceID:     carrier.Get("traceparent"),
+		Kind:        protobuf.TimerKind_Building,
+		Reply:       g.callbacks[callback].Subject,
+		InventoryID: g.ctx.Identity(),
+		Duration:    duration,
+		Data: &structpb.Struct{
+			Fields: data,
+		},
+		Timestamp: timestamppb.New(g.clock.Now()),
+		Amount:    0,
+	})
+
+	if err != nil {
+		return uuid.Nil, time.Time{}, err
+	}
+
+	if res.Status == protobuf.Status_Error {
+		return uuid.Nil, time.Time{}, fmt.Errorf("%s", res.Error)
+	}
+
+	slog.Debug("building timer response",
+		slog.Int("status", int(res.Status)),
+		slog.Time("deadline", res.Deadline.AsTime()),
+		slog.Time("timestamp", res.Timestamp.AsTime()),
+	)
+
+	g.timers[timerID] = struct{}{}
+
+	return timerID, res.Deadline.AsTime(), nil
+}
+
+// cancelConstruction removes a queued building, stops its construction timer and refunds the reserved resources
+func (g *Grain) cancelConstruction(buildingID uuid.UUID) error {
+	for _, register := range g.buildings {
+		register.mx.Lock()
+		build, ok := register.Queue[buildingID]
+
+		if ok {
+			delete(register.Queue, buildingID)
+		}
+		register.mx.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		if build.TimerID != uuid.Nil {
+			g.stopTimer(build.TimerID)
+		}
+
+		g.removeOrder(buildingID)
+
+		refund := blueprints.DefaultCancelRefund
+		if blueprint, err := registry.GetBuilding(register.Name); err == nil {
+			refund = blueprint.GetCancelRefund()
+		} else {
+			slog.Warn("failed to retrieve blueprint from registry, refunding in full", "name", register.Name)
+		}
+
+		g.refundReserved(build.ReservedResources, refund)
+
+		slog.Info("cancelled building", "name", string(register.Name), "id", buildingID.String(), "refund", refund)
+
+		return nil
+	}
+
+	return BuildingNotFoundError{BuildingID: buildingID.String()}
+}
+
+// removeOrder drops a building from the list of buildings waiting for a construction slot
+func (g *Grain) removeOrder(buildingID uuid.UUID) {
+	for i, order := range g.queue {
+		if order.BuildingID == buildingID {
+			g.queue = append(g.queue[:i], g.queue[i+1:]...)
+			return
+		}
+	}
+}
+
+// refundReserved clears the reservations of a cancelled building. Temporary costs are returned
+// in full, permanent ones are refunded according to the given percentage.
+func (g *Grain) refundReserved(reserved []ReservedResource, percent int) {
+	for _, r := range reserved {
+		resource, ok := g.resources[blueprints.ResourceName(r.Name)]
+		if !ok {
+			slog.Warn("reserved resource not found", "resource", r.Name)
+			continue
+		}
+
+		refund := r.Amount
+		if r.Permanent {
+			refund = r.Amount * percent / 100
+		}
+
+		resource.mx.Lock()
+		resource.Reserved -= r.Amount
+		resource.Amount += refund
+		resource.mx.Unlock()
+	}
+}
+
+// stopTimer cancels a timer grain so it won't fire anymore and returns the resources
+// it reserved for its current tick
+func (g *Grain) stopTimer(timerID uuid.UUID) {
+	delete(g.timers, timerID)
+	g.releaseTickReservation(timerID)
+
+	// The timer may be waiting on a Reserve call to this grain, so don't block on the reply
+	client := g.timer(timerID)
+
+	go func() {
+		res, err := client.Cancel(&protobuf.CancelTimerRequest{
+			TraceID:   "",
+			Timestamp: timestamppb.New(g.clock.Now()),
+		})
+		if err != nil {
+			slog.Warn("failed to cancel timer", err, "timer_id", timerID.String())
+			return
+		}
+
+		if res.Status == protobuf.Status_Error {
+			slog.Warn("failed to cancel timer", "timer_id", timerID.String(), "error", res.Error)
+		}
+	}()
+}
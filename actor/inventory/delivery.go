@@ -0,0 +1,133 @@
+package inventory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	intnats "github.com/0xa1-red/empires-of-avalon/transport/nats"
+	"github.com/nats-io/nats.go"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/proto"
+)
+
+// fireLogRetention is how long applied fires are remembered, well past any redelivery
+const fireLogRetention = 24 * time.Hour
+
+// FireLog keeps the last applied fire of every timer, so a redelivered TimerFired message
+// doesn't credit the same tick twice
+type FireLog struct {
+	mx *sync.Mutex
+
+	Timers map[string]AppliedFire
+}
+
+type AppliedFire struct {
+	Sequence  int64
+	AppliedAt time.Time
+}
+
+func NewFireLog() *FireLog {
+	return &FireLog{
+		mx:     &sync.Mutex{},
+		Timers: make(map[string]AppliedFire),
+	}
+}
+
+// Record marks a fire as applied. It returns false if the fire or a later one of the same timer
+// was applied already. Fires without a sequence predate the log and are always applied.
+func (fl *FireLog) Record(timerID string, sequence int64, now time.Time) bool {
+	if sequence == 0 {
+		return true
+	}
+
+	fl.mx.Lock()
+	defer fl.mx.Unlock()
+
+	for id, fire := range fl.Timers {
+		if now.Sub(fire.AppliedAt) > fireLogRetention {
+			delete(fl.Timers, id)
+		}
+	}
+
+	if last, ok := fl.Timers[timerID]; ok && last.Sequence >= sequence {
+		return false
+	}
+
+	fl.Timers[timerID] = AppliedFire{
+		Sequence:  sequence,
+		AppliedAt: now,
+	}
+
+	return true
+}
+
+// durableFire is a TimerFired message from JetStream waiting in the mailbox of the grain
+type durableFire struct {
+	msg      *nats.Msg
+	callback *Callback
+	fired    *protobuf.TimerFired
+}
+
+// deliver wraps a callback so fires that were applied already are skipped
+func (g *Grain) deliver(cb *Callback) func(*protobuf.TimerFired) {
+	return func(t *protobuf.TimerFired) {
+		if !g.fires.Record(t.TimerID, t.Sequence, g.clock.Now()) {
+			slog.Debug("skipping applied fire", "callback", cb.Name, "timer_id", t.TimerID, "sequence", t.Sequence)
+			return
+		}
+
+		cb.Method(t)
+	}
+}
+
+// subscribeDurable consumes the timer callbacks of the inventory from JetStream. The messages go
+// through the mailbox, so they are applied in order with the requests to the grain, and they're
+// only acked once applied.
+func (g *Grain) subscribeDurable() error {
+	callbacks := make(map[string]*Callback)
+	for _, cb := range g.callbacks {
+		callbacks[cb.Subject] = cb
+	}
+
+	sub, err := intnats.SubscribeDurable(g.ctx.Identity(), func(msg *nats.Msg) {
+		cb, ok := callbacks[msg.Subject]
+		if !ok {
+			slog.Warn("no callback for subject", "subject", msg.Subject)
+			msg.Term() // nolint:errcheck
+
+			return
+		}
+
+		fired := &protobuf.TimerFired{} // nolint:exhaustruct
+		if err := proto.Unmarshal(msg.Data, fired); err != nil {
+			slog.Error("failed to decode TimerFired message", err, "subject", msg.Subject)
+			msg.Term() // nolint:errcheck
+
+			return
+		}
+
+		g.ctx.Send(g.ctx.Self(), &durableFire{
+			msg:      msg,
+			callback: cb,
+			fired:    fired,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.Debug("subscribed to durable callbacks", "inventory", g.ctx.Identity())
+
+	g.subscriptions[CallbackDurable] = sub
+
+	return nil
+}
+
+func (g *Grain) applyDurableFire(f *durableFire) {
+	g.deliver(f.callback)(f.fired)
+
+	if err := f.msg.Ack(); err != nil {
+		slog.Warn("failed to ack TimerFired message", err, "timer_id", f.fired.TimerID, "sequence", f.fired.Sequence)
+	}
+}
@@ -0,0 +1,91 @@
+package inventory
+
+import (
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slog"
+)
+
+// demolishBuilding removes a completed building, stops its generator and transformer timers,
+// releases its workers and returns the salvaged part of its permanent cost
+func (g *Grain) demolishBuilding(buildingID uuid.UUID) error {
+	for _, register := range g.buildings {
+		register.mx.Lock()
+		building, ok := register.Completed[buildingID]
+
+		if ok {
+			delete(register.Completed, buildingID)
+		}
+		register.mx.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		g.stopBuildingTimers(building)
+		g.cancelUpgrade(building)
+
+		if err := g.reserveWorkers(-building.WorkersCurrent); err != nil {
+			slog.Warn("failed to release workers", "error", err, "id", buildingID.String())
+		}
+
+		blueprint, err := registry.GetBuilding(register.Name)
+		if err != nil {
+			slog.Warn("failed to retrieve blueprint from registry, skipping salvage", "name", register.Name)
+		} else {
+			g.salvage(blueprint, building.CurrentLevel())
+		}
+
+		slog.Info("demolished building", "name", string(register.Name), "id", buildingID.String())
+
+		return nil
+	}
+
+	return BuildingNotFoundError{BuildingID: buildingID.String()}
+}
+
+func (g *Grain) stopBuildingTimers(building Building) {
+	building.stopSettlement()
+
+	if building.Timers == nil {
+		return
+	}
+
+	for _, timerID := range building.Timers.Generators {
+		g.stopTimer(timerID)
+	}
+
+	for _, timerID := range building.Timers.Transformers {
+		g.stopTimer(timerID)
+	}
+
+	building.Timers.Generators = make([]uuid.UUID, 0)
+	building.Timers.Transformers = make([]uuid.UUID, 0)
+}
+
+// salvage returns the blueprint defined percentage of the permanent cost paid for every level of the building
+func (g *Grain) salvage(blueprint *blueprints.Building, level int) {
+	if blueprint.Salvage <= 0 {
+		return
+	}
+
+	costs := make([]blueprints.ResourceCost, 0)
+	for l := 1; l <= level; l++ {
+		costs = append(costs, blueprint.AtLevel(l).Cost...)
+	}
+
+	for _, cost := range costs {
+		if !cost.Permanent {
+			continue
+		}
+
+		resource, ok := g.resources[cost.Resource]
+		if !ok {
+			slog.Warn("salvaged resource not found", "resource", cost.Resource)
+			continue
+		}
+
+		resource.Update(cost.Amount * blueprint.Salvage / 100)
+	}
+}
@@ -2,8 +2,11 @@ package inventory
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type InsufficientResourceError struct {
@@ -21,3 +24,114 @@ type InvalidResourceError struct {
 func (e InvalidResourceError) Error() string {
 	return fmt.Sprintf("invalid resource %s", e.Resource)
 }
+
+type BuildingNotFoundError struct {
+	BuildingID string
+}
+
+func (e BuildingNotFoundError) Error() string {
+	return fmt.Sprintf("building %s not found", e.BuildingID)
+}
+
+type InvalidWorkerAmountError struct {
+	Workers int
+	Maximum int
+}
+
+func (e InvalidWorkerAmountError) Error() string {
+	return fmt.Sprintf("invalid worker amount %d, building accepts 0 to %d workers", e.Workers, e.Maximum)
+}
+
+// TimerError is returned when a timer couldn't be started. Unlike most errors, trying again later
+// may succeed.
+type TimerError struct {
+	Err error
+}
+
+func (e TimerError) Error() string {
+	return fmt.Sprintf("failed to start timer: %v", e.Err)
+}
+
+func (e TimerError) Unwrap() error {
+	return e.Err
+}
+
+type MaxLevelError struct {
+	Name  blueprints.BuildingName
+	Level int
+}
+
+func (e MaxLevelError) Error() string {
+	return fmt.Sprintf("%s is already at its maximum level %d", e.Name, e.Level)
+}
+
+type UpgradeInProgressError struct {
+	BuildingID string
+}
+
+func (e UpgradeInProgressError) Error() string {
+	return fmt.Sprintf("building %s is already being upgraded", e.BuildingID)
+}
+
+// LockedError is returned when a blueprint's prerequisites aren't met
+type LockedError struct {
+	Name             string
+	MissingBuildings map[blueprints.BuildingName]int
+	MissingResearch  []blueprints.ResearchName
+}
+
+func (e LockedError) Error() string {
+	missing := make([]string, 0)
+
+	for name, amount := range e.MissingBuildings {
+		missing = append(missing, fmt.Sprintf("%d %s", amount, name))
+	}
+
+	for _, name := range e.MissingResearch {
+		missing = append(missing, name.String())
+	}
+
+	sort.Strings(missing)
+
+	return fmt.Sprintf("%s is locked, requires %s", e.Name, strings.Join(missing, ", "))
+}
+
+// Reason returns the missing prerequisites in a form that can be sent to clients
+func (e LockedError) Reason() *structpb.Struct {
+	buildings := make(map[string]interface{})
+	for name, amount := range e.MissingBuildings {
+		buildings[name.String()] = amount
+	}
+
+	research := make([]interface{}, 0)
+	for _, name := range e.MissingResearch {
+		research = append(research, name.String())
+	}
+
+	reason, err := structpb.NewStruct(map[string]interface{}{
+		"name":              e.Name,
+		"missing_buildings": buildings,
+		"missing_research":  research,
+	})
+	if err != nil {
+		return nil
+	}
+
+	return reason
+}
+
+type ResearchCompletedError struct {
+	Name blueprints.ResearchName
+}
+
+func (e ResearchCompletedError) Error() string {
+	return fmt.Sprintf("research %s is already completed", e.Name)
+}
+
+type ResearchInProgressError struct {
+	Name blueprints.ResearchName
+}
+
+func (e ResearchInProgressError) Error() string {
+	return fmt.Sprintf("research %s is already in progress", e.Name)
+}
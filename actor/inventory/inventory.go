@@ -2,21 +2,25 @@ package inventory
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/0xa1-red/empires-of-avalon/actor"
+	"github.com/0xa1-red/empires-of-avalon/config"
 	"github.com/0xa1-red/empires-of-avalon/instrumentation/traces"
 	"github.com/0xa1-red/empires-of-avalon/persistence"
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
 	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/0xa1-red/empires-of-avalon/transport"
 	intnats "github.com/0xa1-red/empires-of-avalon/transport/nats"
 	"github.com/asynkron/protoactor-go/cluster"
 	"github.com/google/uuid"
-	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"golang.org/x/exp/slog"
@@ -28,16 +32,25 @@ const (
 	CallbackBuildings    = "buildings"
 	CallbackGenerators   = "generators"
 	CallbackTransformers = "transformers"
+	CallbackUpgrades     = "upgrades"
+	CallbackResearch     = "research"
 	CallbackTimerStopped = "timer-stopped"
+	CallbackRegistry     = "registry"
+	CallbackDurable      = "durable"
 
 	SubjectTimerStatus = "timer-status"
 
+	// TimerForever is the repeat amount of production timers, they run until they're cancelled
+	TimerForever = -1
+
 	KeyBuilding          = "building"
 	KeyId                = "id"
 	KeyDisableGenerators = "disable_generators"
 
 	KeyResource = "resource"
 	KeyAmount   = "amount"
+	KeyLevel    = "level"
+	KeyResearch = "research"
 
 	StateQueued   = "queued"
 	StateInactive = "inactive"
@@ -57,6 +70,11 @@ type BuildingRegister struct {
 	Name        blueprints.BuildingName
 	Completed   map[uuid.UUID]Building
 	Queue       map[uuid.UUID]Building
+	// Version is the version of the blueprint the production of the buildings was started with
+	Version int
+	// Production holds what the buildings produce at every level of that version, production is
+	// settled against it until the buildings are migrated to a later version
+	Production map[int]Production
 }
 
 type TimerRegister struct {
@@ -75,15 +93,44 @@ func NewTimerRegister() *TimerRegister {
 	}
 }
 
+// timerClient is the part of the timer grain client the inventory uses
+type timerClient interface {
+	CreateTimer(r *protobuf.TimerRequest, opts ...cluster.GrainCallOption) (*protobuf.TimerResponse, error)
+	Cancel(r *protobuf.CancelTimerRequest, opts ...cluster.GrainCallOption) (*protobuf.TimerResponse, error)
+}
+
 type Grain struct {
-	ctx cluster.GrainContext
+	ctx   cluster.GrainContext
+	clock clock.Clock
+	// timerClient returns the client of a timer, nil if the timers run on timer grains
+	timerClient func(timerID uuid.UUID) timerClient
 
 	buildings       map[uuid.UUID]*BuildingRegister
 	resources       map[blueprints.ResourceName]*ResourceRegister
-	subscriptions   map[string]*nats.Subscription
+	subscriptions   map[string]transport.Subscription
 	callbacks       map[string]*Callback
-	heartbeatTicker *time.Ticker
+	heartbeatTicker clock.Ticker
 	timers          map[uuid.UUID]struct{}
+	queue           []ConstructionOrder
+	// queueRetry stops the pending retry of the queue, queueBackoff is the delay it was scheduled with
+	queueRetry   chan struct{}
+	queueBackoff time.Duration
+
+	tickReservations *TickReservations
+	research         *ResearchRegister
+	fires            *FireLog
+	// revision is the registry revision the inventory was last migrated at
+	revision int64
+	// productionMode is how the production of the buildings is credited, see config.ProductionModeLazy
+	productionMode string
+}
+
+// New creates an inventory grain that takes its time from the given clock
+func New(clk clock.Clock) *Grain {
+	return &Grain{ // nolint:exhaustruct
+		clock:          clk,
+		productionMode: viper.GetString(config.Inventory_Production_Mode),
+	}
 }
 
 type Callback struct {
@@ -93,24 +140,82 @@ type Callback struct {
 }
 
 func (g *Grain) Init(ctx cluster.GrainContext) {
+	g.setup(ctx)
+	g.initCallbacks()
+	g.startProduction()
+
+	if err := g.subscribeToTimerStopped(); err != nil {
+		slog.Error("failed to subscribe to callback", err,
+			"callback", CallbackTimerStopped,
+			"subject", SubjectTimerStatus,
+		)
+	}
+
+	if err := g.subscribeToRegistryUpdates(); err != nil {
+		slog.Error("failed to subscribe to callback", err,
+			"callback", CallbackRegistry,
+			"subject", registry.SubjectUpdates,
+		)
+	}
+
+	if err := actor.SendUpdate(&protobuf.GrainUpdate{ // nolint:exhaustruct
+		UpdateKind: protobuf.UpdateKind_Register,
+		GrainKind:  protobuf.GrainKind_InventoryGrain,
+		Timestamp:  timestamppb.New(g.clock.Now()),
+		Identity:   g.ctx.Self().String(),
+	}); err != nil {
+		slog.Warn("failed to send register update to admin actor", err)
+	}
+
+	g.heartbeatTicker = g.clock.NewTicker(30 * time.Second)
+	go func() {
+		for curTime := range g.heartbeatTicker.C() {
+			if err := actor.SendUpdate(&protobuf.GrainUpdate{ // nolint:exhaustruct
+				UpdateKind: protobuf.UpdateKind_Heartbeat,
+				GrainKind:  protobuf.GrainKind_InventoryGrain,
+				Timestamp:  timestamppb.New(curTime),
+				Identity:   g.ctx.Self().String(),
+			}); err != nil {
+				slog.Warn("failed to send register update to admin actor", err)
+			}
+		}
+	}()
+}
+
+// setup creates the registers of a new inventory with the starting buildings and resources
+func (g *Grain) setup(ctx cluster.GrainContext) {
 	g.ctx = ctx
-	g.subscriptions = make(map[string]*nats.Subscription)
+	g.subscriptions = make(map[string]transport.Subscription)
 	g.timers = make(map[uuid.UUID]struct{})
+	g.queue = make([]ConstructionOrder, 0)
+	g.tickReservations = NewTickReservations()
+	g.research = NewResearchRegister()
+	g.fires = NewFireLog()
 	g.callbacks = map[string]*Callback{
 		CallbackGenerators: {
 			Name:    CallbackGenerators,
 			Method:  g.generatorCallback,
-			Subject: fmt.Sprintf("%s-resource-callbacks", ctx.Identity()),
+			Subject: intnats.CallbackSubject(ctx.Identity(), "resource"),
 		},
 		CallbackBuildings: {
 			Name:    CallbackBuildings,
 			Method:  g.buildingCallback,
-			Subject: fmt.Sprintf("%s-building-callbacks", ctx.Identity()),
+			Subject: intnats.CallbackSubject(ctx.Identity(), "building"),
 		},
 		CallbackTransformers: {
 			Name:    CallbackTransformers,
 			Method:  g.transformerCallback,
-			Subject: fmt.Sprintf("%s-transform-callbacks", ctx.Identity()),
+			Subject: intnats.CallbackSubject(ctx.Identity(), "transform"),
+		},
+		CallbackUpgrades: {
+			Name:    CallbackUpgrades,
+			Method:  g.upgradeCallback,
+			Subject: intnats.CallbackSubject(ctx.Identity(), "upgrade"),
+		},
+		CallbackResearch: {
+			Name:    CallbackResearch,
+			Method:  g.researchCallback,
+			Subject: intnats.CallbackSubject(ctx.Identity(), "research"),
 		},
 	}
 
@@ -126,10 +231,12 @@ func (g *Grain) Init(ctx cluster.GrainContext) {
 		slog.Error("failed to get starting resources", startingAssetsError)
 	}
 
+	g.revision = registry.Revision()
 	g.updateLimits()
+}
 
-	g.initCallbacks()
-
+// startProduction starts the generators and transformers of the completed buildings
+func (g *Grain) startProduction() {
 	for blueprintID, register := range g.buildings {
 		bp, err := registry.GetBuilding(register.Name)
 		if err != nil {
@@ -141,39 +248,17 @@ func (g *Grain) Init(ctx cluster.GrainContext) {
 			g.startBuildingTransformers(buildingID, bp)
 		}
 	}
+}
 
-	if err := g.subscribeToTimerStopped(); err != nil {
-		slog.Error("failed to subscribe to callback", err,
-			"callback", CallbackTimerStopped,
-			"subject", SubjectTimerStatus,
-		)
-	}
+func (g *Grain) initCallbacks() {
+	if intnats.JetStreamEnabled() {
+		if err := g.subscribeDurable(); err != nil {
+			slog.Error("failed to subscribe to durable callbacks", err)
+		}
 
-	if err := actor.SendUpdate(&protobuf.GrainUpdate{ // nolint:exhaustruct
-		UpdateKind: protobuf.UpdateKind_Register,
-		GrainKind:  protobuf.GrainKind_InventoryGrain,
-		Timestamp:  timestamppb.Now(),
-		Identity:   g.ctx.Self().String(),
-	}); err != nil {
-		slog.Warn("failed to send register update to admin actor", err)
+		return
 	}
 
-	g.heartbeatTicker = time.NewTicker(30 * time.Second)
-	go func() {
-		for curTime := range g.heartbeatTicker.C {
-			if err := actor.SendUpdate(&protobuf.GrainUpdate{ // nolint:exhaustruct
-				UpdateKind: protobuf.UpdateKind_Heartbeat,
-				GrainKind:  protobuf.GrainKind_InventoryGrain,
-				Timestamp:  timestamppb.New(curTime),
-				Identity:   g.ctx.Self().String(),
-			}); err != nil {
-				slog.Warn("failed to send register update to admin actor", err)
-			}
-		}
-	}()
-}
-
-func (g *Grain) initCallbacks() {
 	for _, cb := range g.callbacks {
 		if err := g.subscribeToCallback(cb); err != nil {
 			slog.Error("failed to subscribe to callback", err,
@@ -185,7 +270,11 @@ func (g *Grain) initCallbacks() {
 }
 
 func (g *Grain) updateLimits() {
+	bonuses := g.capBonuses()
+
 	for _, resource := range g.resources {
+		resource.Bonus = bonuses[resource.Name]
+
 		if err := resource.UpdateCap(g.resources, g.buildings); err != nil {
 			slog.Error("failed to calculate resource cap", err)
 		}
@@ -199,6 +288,8 @@ func (g *Grain) Terminate(ctx cluster.GrainContext) {
 		}
 	}()
 
+	g.cancelQueueRetry()
+
 	if len(g.buildings) == 0 {
 		return
 	}
@@ -212,7 +303,18 @@ func (g *Grain) Terminate(ctx cluster.GrainContext) {
 	}
 }
 
-func (g *Grain) ReceiveDefault(ctx cluster.GrainContext) {}
+func (g *Grain) ReceiveDefault(ctx cluster.GrainContext) {
+	switch msg := ctx.Message().(type) {
+	case *durableFire:
+		g.applyDurableFire(msg)
+	case *retryQueue:
+		g.queueRetry = nil
+		g.processQueue(context.Background())
+	case *protobuf.RegistryUpdate:
+		slog.Debug("blueprint changed", "kind", msg.Kind, "name", msg.Name, "version", msg.Version, "deleted", msg.Deleted)
+		g.reconcile()
+	}
+}
 
 func (g *Grain) StartBuilding(req *protobuf.StartBuildingRequest, ctx cluster.GrainContext) (*protobuf.StartBuildingResponse, error) {
 	carrier := propagation.MapCarrier{}
@@ -222,15 +324,38 @@ func (g *Grain) StartBuilding(req *protobuf.StartBuildingRequest, ctx cluster.Gr
 	sctx, span := traces.Start(pctx, "actor/inventory/start")
 	defer span.End()
 
+	g.reconcile()
+
+	g.settleProduction(g.clock.Now())
+
 	blueprint, err := registry.GetBuilding(blueprints.BuildingName(req.Name))
 	if err != nil {
 		return &protobuf.StartBuildingResponse{
 			Status:    protobuf.Status_Error,
 			Error:     fmt.Sprintf("Invalid building name: %s", req.Name),
-			Timestamp: timestamppb.Now(),
+			Timestamp: timestamppb.New(g.clock.Now()),
+			Reason:    nil,
 		}, nil
 	}
 
+	if err := g.checkPrerequisites(blueprint.Name.String(), blueprint.Requires); err != nil {
+		span.RecordError(err)
+
+		response := &protobuf.StartBuildingResponse{
+			Status:    protobuf.Status_Error,
+			Error:     err.Error(),
+			Timestamp: timestamppb.New(g.clock.Now()),
+			Reason:    nil,
+		}
+
+		var locked LockedError
+		if errors.As(err, &locked) {
+			response.Reason = locked.Reason()
+		}
+
+		return response, nil
+	}
+
 	if _, ok := g.buildings[blueprint.ID]; !ok {
 		g.buildings[blueprint.ID] = &BuildingRegister{
 			mx:          &sync.Mutex{},
@@ -238,105 +363,277 @@ func (g *Grain) StartBuilding(req *protobuf.StartBuildingRequest, ctx cluster.Gr
 			Completed:   make(map[uuid.UUID]Building),
 			Queue:       make(map[uuid.UUID]Building),
 			BlueprintID: blueprint.ID,
+			Version:     blueprint.Version,
+			Production:  productionOf(blueprint),
 		}
 	}
 
-	queue := 0
-	for _, b := range g.buildings {
-		queue += len(b.Queue)
-	}
+	amount := buildAmount(req.Amount)
 
-	if queue > 0 {
+	if insufficient := g.insufficientResources(blueprint.Cost, amount); len(insufficient) > 0 {
 		return &protobuf.StartBuildingResponse{
 			Status:    protobuf.Status_Error,
-			Error:     "All building slots are occupied",
-			Timestamp: timestamppb.Now(),
+			Error:     fmt.Sprintf("Insufficient resources: %s", strings.Join(insufficient, ", ")),
+			Timestamp: timestamppb.New(g.clock.Now()),
+			Reason:    nil,
 		}, nil
 	}
 
-	insufficient := make([]string, 0)
+	slog.Info("requested building", "name", string(blueprint.Name), "amount", amount)
 
-	for _, cost := range blueprint.Cost {
-		if g.resources[cost.Resource].Amount < cost.Amount {
-			insufficient = append(insufficient, string(cost.Resource))
-		}
+	for i := 0; i < amount; i++ {
+		g.enqueueBuilding(blueprint)
 	}
 
-	if len(insufficient) > 0 {
-		return &protobuf.StartBuildingResponse{
+	g.processQueue(sctx)
+
+	return &protobuf.StartBuildingResponse{
+		Status:    protobuf.Status_OK,
+		Timestamp: timestamppb.New(g.clock.Now()),
+		Error:     "",
+		Reason:    nil,
+	}, nil
+}
+
+func (g *Grain) CancelBuilding(req *protobuf.CancelBuildingRequest, ctx cluster.GrainContext) (*protobuf.CancelBuildingResponse, error) {
+	carrier := propagation.MapCarrier{}
+	carrier.Set("traceparent", req.TraceID)
+	pctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	sctx, span := traces.Start(pctx, "actor/inventory/cancel")
+	defer span.End()
+
+	g.reconcile()
+
+	buildingID, err := uuid.Parse(req.BuildingID)
+	if err != nil {
+		return &protobuf.CancelBuildingResponse{
 			Status:    protobuf.Status_Error,
-			Error:     fmt.Sprintf("Insufficient resources: %s", strings.Join(insufficient, ", ")),
-			Timestamp: timestamppb.Now(),
+			Error:     fmt.Sprintf("Invalid building ID: %s", req.BuildingID),
+			Timestamp: timestamppb.New(g.clock.Now()),
 		}, nil
 	}
 
-	buildingID := uuid.New()
-	slog.Info("requested building", "name", string(blueprint.Name), "id", buildingID.String())
+	if err := g.cancelConstruction(buildingID); err != nil {
+		span.RecordError(err)
 
-	otel.GetTextMapPropagator().Inject(sctx, &carrier)
+		return &protobuf.CancelBuildingResponse{
+			Status:    protobuf.Status_Error,
+			Error:     err.Error(),
+			Timestamp: timestamppb.New(g.clock.Now()),
+		}, nil
+	}
 
-	timerID := uuid.New()
-	timer := protobuf.GetTimerGrainClient(g.ctx.Cluster(), timerID.String())
-	res, err := timer.CreateTimer(&protobuf.TimerRequest{
-		TimerID:     timerID.String(),
-		TraceID:     carrier.Get("traceparent"),
-		Kind:        protobuf.TimerKind_Building,
-		Reply:       g.callbacks[CallbackBuildings].Subject,
-		InventoryID: g.ctx.Identity(),
-		Duration:    blueprint.BuildTime,
-		Data: &structpb.Struct{
-			Fields: map[string]*structpb.Value{
-				KeyId:       structpb.NewStringValue(buildingID.String()),
-				KeyBuilding: structpb.NewStringValue(string(blueprint.Name)),
-				KeyAmount:   structpb.NewNumberValue(1),
-			},
-		},
-		Timestamp: timestamppb.Now(),
-	})
+	g.processQueue(sctx)
+
+	return &protobuf.CancelBuildingResponse{
+		Status:    protobuf.Status_OK,
+		Error:     "",
+		Timestamp: timestamppb.New(g.clock.Now()),
+	}, nil
+}
+
+func (g *Grain) Demolish(req *protobuf.DemolishRequest, ctx cluster.GrainContext) (*protobuf.DemolishResponse, error) {
+	carrier := propagation.MapCarrier{}
+	carrier.Set("traceparent", req.TraceID)
+	pctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
 
+	sctx, span := traces.Start(pctx, "actor/inventory/demolish")
+	defer span.End()
+
+	g.reconcile()
+
+	g.settleProduction(g.clock.Now())
+
+	buildingID, err := uuid.Parse(req.BuildingID)
 	if err != nil {
-		return &protobuf.StartBuildingResponse{
+		return &protobuf.DemolishResponse{
+			Status:    protobuf.Status_Error,
+			Error:     fmt.Sprintf("Invalid building ID: %s", req.BuildingID),
+			Timestamp: timestamppb.New(g.clock.Now()),
+		}, nil
+	}
+
+	if err := g.demolishBuilding(buildingID); err != nil {
+		span.RecordError(err)
+
+		return &protobuf.DemolishResponse{
 			Status:    protobuf.Status_Error,
 			Error:     err.Error(),
-			Timestamp: timestamppb.Now(),
+			Timestamp: timestamppb.New(g.clock.Now()),
 		}, nil
 	}
 
-	slog.Debug("building timer response",
-		slog.Int("status", int(res.Status)),
-		slog.Time("deadline", res.Deadline.AsTime()),
-		slog.Time("timestamp", res.Timestamp.AsTime()),
-	)
+	// Losing a building can shrink both storage caps and the number of construction slots
+	g.updateLimits()
+	g.processQueue(sctx)
 
-	g.timers[timerID] = struct{}{}
+	return &protobuf.DemolishResponse{
+		Status:    protobuf.Status_OK,
+		Error:     "",
+		Timestamp: timestamppb.New(g.clock.Now()),
+	}, nil
+}
 
-	reserved := make([]ReservedResource, 0)
-	for _, resource := range blueprint.Cost {
-		reserved = append(reserved, ReservedResource{
-			Name:      string(resource.Resource),
-			Amount:    resource.Amount,
-			Permanent: resource.Permanent,
-		})
+func (g *Grain) AssignWorkers(req *protobuf.AssignWorkersRequest, ctx cluster.GrainContext) (*protobuf.AssignWorkersResponse, error) {
+	carrier := propagation.MapCarrier{}
+	carrier.Set("traceparent", req.TraceID)
+	pctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	_, span := traces.Start(pctx, "actor/inventory/assign_workers")
+	defer span.End()
+
+	g.reconcile()
+
+	g.settleProduction(g.clock.Now())
+
+	buildingID, err := uuid.Parse(req.BuildingID)
+	if err != nil {
+		return &protobuf.AssignWorkersResponse{
+			Status:    protobuf.Status_Error,
+			Error:     fmt.Sprintf("Invalid building ID: %s", req.BuildingID),
+			Timestamp: timestamppb.New(g.clock.Now()),
+		}, nil
+	}
+
+	if err := g.assignWorkers(buildingID, int(req.Workers)); err != nil {
+		span.RecordError(err)
+
+		return &protobuf.AssignWorkersResponse{
+			Status:    protobuf.Status_Error,
+			Error:     err.Error(),
+			Timestamp: timestamppb.New(g.clock.Now()),
+		}, nil
 	}
 
-	build := Building{
-		ID:                buildingID,
-		BlueprintID:       blueprint.ID,
-		Name:              blueprint.Name,
-		State:             protobuf.BuildingState_BuildingStateQueued,
-		WorkersMaximum:    blueprint.WorkersMaximum,
-		WorkersCurrent:    0,
-		Completion:        res.Deadline.AsTime(),
-		ReservedResources: reserved,
-		Timers:            NewTimerRegister(),
+	return &protobuf.AssignWorkersResponse{
+		Status:    protobuf.Status_OK,
+		Error:     "",
+		Timestamp: timestamppb.New(g.clock.Now()),
+	}, nil
+}
+
+func (g *Grain) SetBuildingActive(req *protobuf.SetBuildingActiveRequest, ctx cluster.GrainContext) (*protobuf.SetBuildingActiveResponse, error) {
+	carrier := propagation.MapCarrier{}
+	carrier.Set("traceparent", req.TraceID)
+	pctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	_, span := traces.Start(pctx, "actor/inventory/set_building_active")
+	defer span.End()
+
+	g.reconcile()
+
+	g.settleProduction(g.clock.Now())
+
+	buildingID, err := uuid.Parse(req.BuildingID)
+	if err != nil {
+		return &protobuf.SetBuildingActiveResponse{
+			Status:    protobuf.Status_Error,
+			Error:     fmt.Sprintf("Invalid building ID: %s", req.BuildingID),
+			Timestamp: timestamppb.New(g.clock.Now()),
+		}, nil
 	}
 
-	g.buildings[blueprint.ID].Queue[buildingID] = build
+	if err := g.setBuildingActive(buildingID, req.Active); err != nil {
+		span.RecordError(err)
 
-	return &protobuf.StartBuildingResponse{
+		return &protobuf.SetBuildingActiveResponse{
+			Status:    protobuf.Status_Error,
+			Error:     err.Error(),
+			Timestamp: timestamppb.New(g.clock.Now()),
+		}, nil
+	}
+
+	return &protobuf.SetBuildingActiveResponse{
 		Status:    protobuf.Status_OK,
-		Timestamp: timestamppb.Now(),
 		Error:     "",
+		Timestamp: timestamppb.New(g.clock.Now()),
+	}, nil
+}
+
+func (g *Grain) UpgradeBuilding(req *protobuf.UpgradeBuildingRequest, ctx cluster.GrainContext) (*protobuf.UpgradeBuildingResponse, error) {
+	carrier := propagation.MapCarrier{}
+	carrier.Set("traceparent", req.TraceID)
+	pctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	sctx, span := traces.Start(pctx, "actor/inventory/upgrade")
+	defer span.End()
+
+	g.reconcile()
+
+	g.settleProduction(g.clock.Now())
+
+	buildingID, err := uuid.Parse(req.BuildingID)
+	if err != nil {
+		return &protobuf.UpgradeBuildingResponse{
+			Status:    protobuf.Status_Error,
+			Error:     fmt.Sprintf("Invalid building ID: %s", req.BuildingID),
+			Timestamp: timestamppb.New(g.clock.Now()),
+		}, nil
+	}
+
+	if err := g.startUpgrade(sctx, buildingID); err != nil {
+		span.RecordError(err)
+
+		return &protobuf.UpgradeBuildingResponse{
+			Status:    protobuf.Status_Error,
+			Error:     err.Error(),
+			Timestamp: timestamppb.New(g.clock.Now()),
+		}, nil
+	}
+
+	return &protobuf.UpgradeBuildingResponse{
+		Status:    protobuf.Status_OK,
+		Error:     "",
+		Timestamp: timestamppb.New(g.clock.Now()),
+	}, nil
+}
+
+func (g *Grain) StartResearch(req *protobuf.StartResearchRequest, ctx cluster.GrainContext) (*protobuf.StartResearchResponse, error) {
+	carrier := propagation.MapCarrier{}
+	carrier.Set("traceparent", req.TraceID)
+	pctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	sctx, span := traces.Start(pctx, "actor/inventory/start_research")
+	defer span.End()
+
+	g.reconcile()
+
+	g.settleProduction(g.clock.Now())
+
+	research, err := registry.GetResearch(blueprints.ResearchName(req.Name))
+	if err != nil {
+		return &protobuf.StartResearchResponse{
+			Status:    protobuf.Status_Error,
+			Error:     fmt.Sprintf("Invalid research name: %s", req.Name),
+			Timestamp: timestamppb.New(g.clock.Now()),
+			Reason:    nil,
+		}, nil
+	}
+
+	if err := g.startResearch(sctx, research); err != nil {
+		span.RecordError(err)
+
+		response := &protobuf.StartResearchResponse{
+			Status:    protobuf.Status_Error,
+			Error:     err.Error(),
+			Timestamp: timestamppb.New(g.clock.Now()),
+			Reason:    nil,
+		}
+
+		var locked LockedError
+		if errors.As(err, &locked) {
+			response.Reason = locked.Reason()
+		}
+
+		return response, nil
+	}
+
+	return &protobuf.StartResearchResponse{
+		Status:    protobuf.Status_OK,
+		Error:     "",
+		Timestamp: timestamppb.New(g.clock.Now()),
+		Reason:    nil,
 	}, nil
 }
 
@@ -348,6 +645,10 @@ func (g *Grain) Describe(req *protobuf.DescribeInventoryRequest, ctx cluster.Gra
 	nctx, span := traces.Start(pctx, "actor/inventory/describe")
 	defer span.End()
 
+	g.reconcile()
+
+	g.settleProduction(g.clock.Now())
+
 	buildingValues := g.describeBuildings(nctx)
 	resourceValues := g.describeResources(nctx)
 
@@ -358,6 +659,14 @@ func (g *Grain) Describe(req *protobuf.DescribeInventoryRequest, ctx cluster.Gra
 		"resources": structpb.NewStructValue(&structpb.Struct{
 			Fields: resourceValues,
 		}),
+		"research": g.describeResearch(),
+		"construction": structpb.NewStructValue(&structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"slots":  structpb.NewNumberValue(float64(g.constructionSlots())),
+				"active": structpb.NewNumberValue(float64(g.activeConstructions())),
+				"queued": structpb.NewNumberValue(float64(len(g.queue))),
+			},
+		}),
 	}
 
 	if req.GetTimers {
@@ -380,14 +689,23 @@ func (g *Grain) Describe(req *protobuf.DescribeInventoryRequest, ctx cluster.Gra
 
 	return &protobuf.DescribeInventoryResponse{
 		Inventory: inventory,
-		Timestamp: timestamppb.Now(),
+		Timestamp: timestamppb.New(g.clock.Now()),
 	}, nil
 }
 
+// timer returns the client of a timer grain
+func (g *Grain) timer(timerID uuid.UUID) timerClient {
+	if g.timerClient != nil {
+		return g.timerClient(timerID)
+	}
+
+	return protobuf.GetTimerGrainClient(g.ctx.Cluster(), timerID.String())
+}
+
 func (g *Grain) getStartingBuildings() (map[uuid.UUID]*BuildingRegister, error) {
 	registers := make(map[uuid.UUID]*BuildingRegister)
 
-	now := time.Now()
+	now := g.clock.Now()
 
 	buildings, err := registry.GetBuildings()
 	if err != nil {
@@ -399,7 +717,7 @@ func (g *Grain) getStartingBuildings() (map[uuid.UUID]*BuildingRegister, error)
 
 		for i := 0; i < blueprint.InitialAmount; i++ {
 			id := uuid.New()
-			completed[id] = Building{
+			building := Building{
 				ID:                id,
 				BlueprintID:       blueprint.ID,
 				Name:              blueprint.Name,
@@ -409,7 +727,14 @@ func (g *Grain) getStartingBuildings() (map[uuid.UUID]*BuildingRegister, error)
 				Completion:        now,
 				ReservedResources: make([]ReservedResource, 0),
 				Timers:            NewTimerRegister(),
+				TimerID:           uuid.Nil,
+				Paused:            false,
+				Level:             1,
+				Upgrade:           nil,
+				Settled:           make(map[string]time.Time),
 			}
+			building.State = building.completedState()
+			completed[id] = building
 		}
 
 		registers[blueprint.ID] = &BuildingRegister{
@@ -418,19 +743,20 @@ func (g *Grain) getStartingBuildings() (map[uuid.UUID]*BuildingRegister, error)
 			Completed:   completed,
 			Queue:       make(map[uuid.UUID]Building),
 			BlueprintID: blueprint.ID,
+			Version:     blueprint.Version,
+			Production:  productionOf(blueprint),
 		}
 	}
 
 	return registers, nil
 }
 
-func (g *Grain) startGenerator(generator blueprints.Generator) (uuid.UUID, error) {
+func (g *Grain) startGenerator(buildingID uuid.UUID, generator blueprints.Generator) (uuid.UUID, error) {
 	slog.Debug("starting generator", "name", generator.Name)
 
 	timerID := uuid.New()
 
-	timer := protobuf.GetTimerGrainClient(g.ctx.Cluster(), timerID.String())
-	res, err := timer.CreateTimer(&protobuf.TimerRequest{
+	res, err := g.timer(timerID).CreateTimer(&protobuf.TimerRequest{
 		TimerID:     timerID.String(),
 		TraceID:     "",
 		Kind:        protobuf.TimerKind_Generator,
@@ -439,11 +765,13 @@ func (g *Grain) startGenerator(generator blueprints.Generator) (uuid.UUID, error
 		InventoryID: g.ctx.Identity(),
 		Data: &structpb.Struct{
 			Fields: map[string]*structpb.Value{
-				"resource": structpb.NewStringValue(string(generator.Name)),
-				"amount":   structpb.NewNumberValue(float64(generator.Amount)),
+				KeyId:       structpb.NewStringValue(buildingID.String()),
+				KeyResource: structpb.NewStringValue(string(generator.Name)),
+				KeyAmount:   structpb.NewNumberValue(float64(generator.Amount)),
 			},
 		},
-		Timestamp: timestamppb.Now(),
+		Timestamp: timestamppb.New(g.clock.Now()),
+		Amount:    TimerForever,
 	})
 
 	if err != nil {
@@ -459,13 +787,12 @@ func (g *Grain) startGenerator(generator blueprints.Generator) (uuid.UUID, error
 	return timerID, nil
 }
 
-func (g *Grain) startTransformer(transformer blueprints.Transformer) (uuid.UUID, error) {
+func (g *Grain) startTransformer(buildingID uuid.UUID, transformer blueprints.Transformer) (uuid.UUID, error) {
 	slog.Debug("starting transformer", "name", transformer.Name)
 
 	timerID := uuid.New()
 
-	timer := protobuf.GetTimerGrainClient(g.ctx.Cluster(), timerID.String())
-	res, err := timer.CreateTimer(&protobuf.TimerRequest{
+	res, err := g.timer(timerID).CreateTimer(&protobuf.TimerRequest{
 		TimerID:     timerID.String(),
 		TraceID:     "",
 		Kind:        protobuf.TimerKind_Transformer,
@@ -474,11 +801,13 @@ func (g *Grain) startTransformer(transformer blueprints.Transformer) (uuid.UUID,
 		InventoryID: g.ctx.Identity(),
 		Data: &structpb.Struct{
 			Fields: map[string]*structpb.Value{
+				KeyId:    structpb.NewStringValue(buildingID.String()),
 				"cost":   structpb.NewListValue(transformer.CostStructList()),
 				"result": structpb.NewListValue(transformer.ResultStructList()),
 			},
 		},
-		Timestamp: timestamppb.Now(),
+		Timestamp: timestamppb.New(g.clock.Now()),
+		Amount:    TimerForever,
 	})
 
 	if err != nil {
@@ -502,12 +831,12 @@ func (g *Grain) startTransformer(transformer blueprints.Transformer) (uuid.UUID,
 func (g *Grain) subscribeToCallback(cb *Callback) error {
 	subject := cb.Subject
 
-	transport, err := intnats.GetConnection()
+	conn, err := transport.Get()
 	if err != nil {
 		return err
 	}
 
-	sub, err := transport.Subscribe(subject, cb.Method)
+	sub, err := conn.Subscribe(subject, g.deliver(cb))
 
 	if err != nil {
 		return err
@@ -539,25 +868,15 @@ func (g *Grain) buildingCallback(t *protobuf.TimerFired) {
 		return
 	}
 
-	g.buildings[blueprint.ID].mx.Lock()
-	defer g.buildings[blueprint.ID].mx.Unlock()
+	// Production up to now happened with the caps and reservations from before the building
+	g.settleProduction(g.clock.Now())
 
-	slog.Debug("finished building", "building", blueprint.Name)
-	b := g.buildings[blueprint.ID].Queue[buildingID]
-	b.State = protobuf.BuildingState_BuildingStateActive
-	b.Completion = time.Now()
-	g.buildings[blueprint.ID].Completed[buildingID] = b
-
-	delete(g.buildings[blueprint.ID].Queue, buildingID)
-
-	for _, cost := range blueprint.Cost {
-		if !cost.Permanent {
-			g.resources[cost.Resource].Amount += g.resources[cost.Resource].Reserved
-		}
-
-		g.resources[cost.Resource].Reserved = 0
+	if !g.completeBuilding(blueprint, buildingID) {
+		return
 	}
 
+	g.processQueue(context.Background())
+
 	// For testing purposes, we can disable generators if needed
 	if disable, ok := payload[KeyDisableGenerators]; ok && disable.(bool) {
 		slog.Debug("generators are disabled for building", "building", blueprint.Name)
@@ -568,6 +887,38 @@ func (g *Grain) buildingCallback(t *protobuf.TimerFired) {
 	g.startBuildingTransformers(buildingID, blueprint)
 }
 
+func (g *Grain) completeBuilding(blueprint *blueprints.Building, buildingID uuid.UUID) bool {
+	register, ok := g.buildings[blueprint.ID]
+	if !ok {
+		slog.Warn("building register not found", "building", blueprint.Name, "building_id", buildingID.String())
+		return false
+	}
+
+	register.mx.Lock()
+	defer register.mx.Unlock()
+
+	b, ok := register.Queue[buildingID]
+	if !ok {
+		slog.Warn("finished building not found in queue", "building", blueprint.Name, "building_id", buildingID.String())
+		return false
+	}
+
+	slog.Debug("finished building", "building", blueprint.Name)
+
+	b.State = b.completedState()
+	b.Completion = g.clock.Now()
+	b.TimerID = uuid.Nil
+
+	g.releaseReserved(b.ReservedResources)
+	b.ReservedResources = make([]ReservedResource, 0)
+
+	register.Completed[buildingID] = b
+
+	delete(register.Queue, buildingID)
+
+	return true
+}
+
 func (g *Grain) generatorCallback(t *protobuf.TimerFired) {
 	payload := t.Data.AsMap()
 	resourceName := payload[KeyResource].(string)
@@ -579,20 +930,35 @@ func (g *Grain) generatorCallback(t *protobuf.TimerFired) {
 
 	amount := int(payload[KeyAmount].(float64))
 
+	if building, ok := g.timerBuilding(payload); ok {
+		amount = building.scaleOutput(amount)
+	}
+
 	g.resources[resource.Name].Update(amount)
 }
 
 func (g *Grain) transformerCallback(t *protobuf.TimerFired) {
 	payload := t.Data
 
+	// The reservation of this tick is settled below
+	if timerID, err := uuid.Parse(t.TimerID); err == nil && g.tickReservations != nil {
+		g.tickReservations.Take(timerID)
+	}
+
 	reserveCache := map[string]int{}
 	addCache := map[string]int{}
 
+	// Understaffed buildings only transform their share of the reserved cost, the rest is returned
+	scale := func(amount int) int { return amount }
+	if building, ok := g.timerBuilding(payload.AsMap()); ok {
+		scale = building.scaleOutput
+	}
+
 	for _, result := range payload.Fields["result"].GetListValue().Values {
 		r := result.GetStructValue()
 		resource := r.Fields["resource"].GetStringValue()
 		g.resources[blueprints.ResourceName(resource)].mx.Lock()
-		added := int(r.Fields["amount"].GetNumberValue())
+		added := scale(int(r.Fields["amount"].GetNumberValue()))
 
 		g.resources[blueprints.ResourceName(resource)].Amount += added
 
@@ -610,6 +976,9 @@ func (g *Grain) transformerCallback(t *protobuf.TimerFired) {
 		if r.Fields["temporary"].GetBoolValue() {
 			g.resources[blueprints.ResourceName(resource)].Amount += reserved
 			addCache[resource] += reserved
+		} else if unused := reserved - scale(reserved); unused > 0 {
+			g.resources[blueprints.ResourceName(resource)].Amount += unused
+			addCache[resource] += unused
 		}
 
 		g.resources[blueprints.ResourceName(resource)].Reserved -= reserved
@@ -620,6 +989,23 @@ func (g *Grain) transformerCallback(t *protobuf.TimerFired) {
 	slog.Info("transformer callback fired", "removed", reserveCache, "added", addCache)
 }
 
+// timerBuilding returns the completed building a generator or transformer timer belongs to.
+// Timers created before buildings were tracked in the payload don't have one.
+func (g *Grain) timerBuilding(payload map[string]interface{}) (Building, bool) {
+	rawID, ok := payload[KeyId].(string)
+	if !ok {
+		return Building{}, false // nolint:exhaustruct
+	}
+
+	buildingID, err := uuid.Parse(rawID)
+	if err != nil {
+		slog.Warn("failed to parse building ID", "raw", rawID)
+		return Building{}, false // nolint:exhaustruct
+	}
+
+	return g.findCompleted(buildingID)
+}
+
 func (g *Grain) timerStoppedCallback(t *protobuf.TimerStopped) {
 	id, err := uuid.Parse(t.TimerID)
 	if err != nil {
@@ -639,6 +1025,8 @@ func (g *Grain) Reserve(req *protobuf.ReserveRequest, ctx cluster.GrainContext)
 	_, span := traces.Start(pctx, "actor/inventory/reserve")
 	defer span.End()
 
+	g.settleProduction(g.clock.Now())
+
 	resources := req.Resources.AsMap()
 
 	var err error
@@ -673,68 +1061,122 @@ func (g *Grain) Reserve(req *protobuf.ReserveRequest, ctx cluster.GrainContext)
 		}
 
 		return &protobuf.ReserveResponse{
-			Timestamp: timestamppb.Now(),
+			Timestamp: timestamppb.New(g.clock.Now()),
 			Status:    protobuf.Status_Error,
 			Error:     err.Error(),
 		}, nil
 	}
 
+	if timerID, err := uuid.Parse(req.TimerID); err == nil {
+		g.tickReservations.Set(timerID, cache)
+	}
+
 	return &protobuf.ReserveResponse{
-		Timestamp: timestamppb.Now(),
+		Timestamp: timestamppb.New(g.clock.Now()),
 		Status:    protobuf.Status_OK,
 		Error:     "",
 	}, nil
 }
 
 func (g *Grain) startBuildingGenerators(buildingID uuid.UUID, b *blueprints.Building) {
+	completedBuilding, ok := g.completedBuilding(buildingID, b)
+	if !ok {
+		return
+	}
+
+	if g.lazyProduction() {
+		keys := make([]string, 0)
+		for _, gen := range b.AtLevel(completedBuilding.CurrentLevel()).Generates {
+			keys = append(keys, generatorKey(gen))
+		}
+
+		g.startSettlement(completedBuilding, keys, g.clock.Now())
+
+		return
+	}
+
 	timers := make([]uuid.UUID, 0)
 
-	for _, gen := range b.Generates {
-		if timerID, err := g.startGenerator(gen); err != nil {
+	for _, gen := range b.AtLevel(completedBuilding.CurrentLevel()).Generates {
+		if timerID, err := g.startGenerator(buildingID, gen); err != nil {
 			slog.Error("failed to start generator", err, "name", gen.Name)
 		} else {
 			timers = append(timers, timerID)
 		}
 	}
 
-	buildingRegister, ok := g.buildings[b.ID]
-	if !ok {
-		slog.Warn("building register not found", "inventory_id", g.Identity(), "blueprint_id", b.ID)
-		return
-	}
+	completedBuilding.Timers.Generators = timers
+}
 
-	completedBuilding, ok := buildingRegister.Completed[buildingID]
+func (g *Grain) startBuildingTransformers(buildingID uuid.UUID, b *blueprints.Building) {
+	completedBuilding, ok := g.completedBuilding(buildingID, b)
 	if !ok {
-		slog.Warn("building not found", "inventory_id", g.Identity(), "blueprint_id", b.ID, "building_id", buildingID)
 		return
 	}
 
-	completedBuilding.Timers.Generators = timers
-}
-
-func (g *Grain) startBuildingTransformers(buildingID uuid.UUID, b *blueprints.Building) {
 	timers := make([]uuid.UUID, 0)
+	keys := make([]string, 0)
+
+	for _, tr := range b.AtLevel(completedBuilding.CurrentLevel()).Transforms {
+		if !g.researched(tr.Requires...) {
+			slog.Debug("transformer is locked", "name", tr.Name, "requires", tr.Requires)
+			continue
+		}
+
+		if g.lazyProduction() {
+			keys = append(keys, transformerKey(tr))
+			continue
+		}
 
-	for _, tr := range b.Transforms {
-		if timerID, err := g.startTransformer(tr); err != nil {
+		if timerID, err := g.startTransformer(buildingID, tr); err != nil {
 			slog.Error("failed to start transformer", err, "name", tr.Name)
 		} else {
 			timers = append(timers, timerID)
 		}
 	}
 
-	g.buildings[b.ID].Completed[buildingID].Timers.Transformers = timers
+	if g.lazyProduction() {
+		g.startSettlement(completedBuilding, keys, g.clock.Now())
+		return
+	}
+
+	completedBuilding.Timers.Transformers = timers
+}
+
+func (g *Grain) completedBuilding(buildingID uuid.UUID, b *blueprints.Building) (Building, bool) {
+	buildingRegister, ok := g.buildings[b.ID]
+	if !ok {
+		slog.Warn("building register not found", "inventory_id", g.Identity(), "blueprint_id", b.ID)
+		return Building{}, false // nolint:exhaustruct
+	}
+
+	buildingRegister.mx.Lock()
+	defer buildingRegister.mx.Unlock()
+
+	completedBuilding, ok := buildingRegister.Completed[buildingID]
+	if !ok {
+		slog.Warn("building not found", "inventory_id", g.Identity(), "blueprint_id", b.ID, "building_id", buildingID)
+		return Building{}, false // nolint:exhaustruct
+	}
+
+	// Empty timer registers don't survive persistence
+	if completedBuilding.Timers == nil {
+		completedBuilding.Timers = NewTimerRegister()
+		buildingRegister.Completed[buildingID] = completedBuilding
+	}
+
+	return completedBuilding, true
 }
 
 func (g *Grain) subscribeToTimerStopped() error {
 	subject := "timer-status"
 
-	transport, err := intnats.GetConnection()
+	conn, err := transport.Get()
 	if err != nil {
 		return err
 	}
 
-	sub, err := transport.Subscribe(subject, g.timerStoppedCallback)
+	sub, err := conn.Subscribe(subject, g.timerStoppedCallback)
 
 	if err != nil {
 		return err
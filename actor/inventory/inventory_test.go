@@ -1,15 +1,22 @@
 package inventory
 
 import (
+	"context"
+	"errors"
+	"math"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/game"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
 	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
 	"github.com/google/uuid"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -45,11 +52,102 @@ func setupRegistry() error {
 		}
 	}
 
+	if research, err := registry.ReadYaml[*blueprints.Research](absPath); err != nil {
+		slog.Error("failed to read in research blueprints", err)
+		return err
+	} else {
+		for _, r := range research {
+			if err := registry.Push(r); err != nil {
+				slog.Warn("failed to push research blueprint to local registry", "err", err)
+			}
+		}
+	}
+
 	return nil
 }
 
+// recordingTimers stands in for the timer grains and records the timers the inventory starts
+type recordingTimers struct {
+	mx      sync.Mutex
+	created []*protobuf.TimerRequest
+	// failures is the number of timers that fail to start before the next one starts
+	failures int
+}
+
+func (r *recordingTimers) client(uuid.UUID) timerClient {
+	return r
+}
+
+func (r *recordingTimers) CreateTimer(req *protobuf.TimerRequest, opts ...cluster.GrainCallOption) (*protobuf.TimerResponse, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.failures > 0 {
+		r.failures--
+		return nil, errors.New("timer grain unavailable")
+	}
+
+	r.created = append(r.created, req)
+
+	return &protobuf.TimerResponse{ // nolint:exhaustruct
+		TimerID:   req.TimerID,
+		Status:    protobuf.Status_OK,
+		Timestamp: timestamppb.Now(),
+	}, nil
+}
+
+func (r *recordingTimers) Cancel(req *protobuf.CancelTimerRequest, opts ...cluster.GrainCallOption) (*protobuf.TimerResponse, error) {
+	return &protobuf.TimerResponse{ // nolint:exhaustruct
+		Status:    protobuf.Status_OK,
+		Timestamp: timestamppb.Now(),
+	}, nil
+}
+
+// startedFor returns how many timers were started for a building
+func (r *recordingTimers) startedFor(buildingID uuid.UUID) int {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	started := 0
+
+	for _, req := range r.created {
+		if req.Data.Fields[KeyId].GetStringValue() == buildingID.String() {
+			started++
+		}
+	}
+
+	return started
+}
+
+// mailboxContext stands in for the grain context and passes on the messages the grain sends itself
+type mailboxContext struct {
+	simulationContext
+
+	sent    chan interface{}
+	message interface{}
+}
+
+func (c *mailboxContext) Self() *actor.PID {
+	return actor.NewPID("test", SimulationIdentity)
+}
+
+func (c *mailboxContext) Send(pid *actor.PID, message interface{}) {
+	c.sent <- message
+}
+
+func (c *mailboxContext) Message() interface{} {
+	return c.message
+}
+
+// setConfig changes a setting until the end of the test
+func setConfig(t *testing.T, key string, value interface{}) {
+	previous := viper.Get(key)
+	viper.Set(key, value)
+	t.Cleanup(func() { viper.Set(key, previous) })
+}
+
 func TestBuildingCallback(t *testing.T) {
-	g := &Grain{}
+	g := New(clock.NewFake(time.Now()))
 
 	if err := setupRegistry(); err != nil {
 		t.Fatalf("Fail: %v", err)
@@ -97,7 +195,7 @@ func TestBuildingCallback(t *testing.T) {
 }
 
 func TestReserveRequest(t *testing.T) {
-	grain := &Grain{}
+	grain := New(clock.NewFake(time.Now()))
 
 	if err := setupRegistry(); err != nil {
 		t.Fatalf("Fail: %v", err)
@@ -163,3 +261,862 @@ func TestReserveRequest(t *testing.T) {
 		t.Run(tt.label, tf)
 	}
 }
+
+func TestConstructionSlots(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	setConfig(t, config.Inventory_Build_Slots, 1)
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	assert.Equal(t, 1, g.constructionSlots())
+
+	blueprintID := game.GetBuildingID(blueprints.House.String())
+	buildingID := uuid.New()
+
+	g.buildings[blueprintID].Completed[buildingID] = Building{
+		ID:          buildingID,
+		BlueprintID: blueprintID,
+		Name:        blueprints.House,
+		State:       protobuf.BuildingState_BuildingStateActive,
+	}
+
+	assert.Equal(t, 2, g.constructionSlots())
+}
+
+func TestProcessQueue(t *testing.T) {
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	setConfig(t, config.Inventory_Build_Slots, 1)
+
+	g := NewSimulation(time.Now()).grain
+
+	house, err := registry.GetBuilding(blueprints.House)
+	assert.NoError(t, err)
+
+	wood := g.resources[blueprints.Wood].Amount
+
+	// A building of a blueprint the registry doesn't have, and orders without a queued building
+	quarryID, orphanID := uuid.New(), uuid.New()
+	g.buildings[quarryID] = &BuildingRegister{
+		mx:          &sync.Mutex{},
+		BlueprintID: quarryID,
+		Name:        "Quarry",
+		Completed:   make(map[uuid.UUID]Building),
+		Queue: map[uuid.UUID]Building{
+			orphanID: {ID: orphanID, ReservedResources: g.reserveCost(house.Cost)}, // nolint:exhaustruct
+		},
+	}
+
+	g.queue = append(g.queue,
+		ConstructionOrder{BlueprintID: quarryID, BuildingID: orphanID},
+		ConstructionOrder{BlueprintID: house.ID, BuildingID: uuid.New()},
+		ConstructionOrder{BlueprintID: uuid.New(), BuildingID: uuid.New()},
+	)
+
+	buildingID := g.enqueueBuilding(house)
+
+	// The orders that can't be constructed are dropped and refunded instead of holding up the house
+	g.processQueue(context.Background())
+
+	assert.Empty(t, g.queue)
+	assert.Empty(t, g.buildings[quarryID].Queue)
+	assert.NotEqual(t, uuid.Nil, g.buildings[house.ID].Queue[buildingID].TimerID)
+	assert.Equal(t, wood-20, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 20, g.resources[blueprints.Wood].Reserved)
+}
+
+func TestProcessQueueRetry(t *testing.T) {
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	setConfig(t, config.Inventory_Build_Slots, 1)
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+	ctx := &mailboxContext{sent: make(chan interface{}, 1)} // nolint:exhaustruct
+	timers := &recordingTimers{failures: 2}                 // nolint:exhaustruct
+
+	g := New(clk)
+	g.setup(ctx)
+	g.timerClient = timers.client
+
+	house, err := registry.GetBuilding(blueprints.House)
+	assert.NoError(t, err)
+
+	buildingID := g.enqueueBuilding(house)
+
+	// The order stays at the head of the queue and is tried again with a growing backoff
+	g.processQueue(context.Background())
+
+	for _, backoff := range []time.Duration{queueRetryMin, 2 * queueRetryMin} {
+		assert.Len(t, g.queue, 1)
+		assert.Equal(t, backoff, g.queueBackoff)
+
+		clk.BlockUntil(1)
+		clk.Advance(backoff)
+
+		ctx.message = <-ctx.sent
+		assert.IsType(t, &retryQueue{}, ctx.message)
+		g.ReceiveDefault(ctx)
+	}
+
+	assert.Empty(t, g.queue)
+	assert.NotEqual(t, uuid.Nil, g.buildings[house.ID].Queue[buildingID].TimerID)
+	assert.Equal(t, time.Duration(0), g.queueBackoff)
+	assert.Nil(t, g.queueRetry)
+}
+
+func TestBuildAmount(t *testing.T) {
+	setConfig(t, config.Inventory_Max_Build_Amount, 3)
+	assert.Equal(t, 1, buildAmount(0))
+	assert.Equal(t, 1, buildAmount(-5))
+	assert.Equal(t, 2, buildAmount(2))
+	assert.Equal(t, 3, buildAmount(math.MaxInt64))
+
+	// Without a configured maximum a request still can't overflow the cost of the buildings
+	setConfig(t, config.Inventory_Max_Build_Amount, 0)
+	assert.Equal(t, maxBuildAmount, buildAmount(math.MaxInt64))
+}
+
+func TestBuildingCallbackReleasesReservation(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	house, err := registry.GetBuilding(blueprints.House)
+	assert.NoError(t, err)
+
+	assert.Empty(t, g.insufficientResources(house.Cost, 1))
+	assert.Empty(t, g.insufficientResources(house.Cost, 3))
+	assert.Equal(t, []string{string(blueprints.Population)}, g.insufficientResources(house.Cost, 4))
+
+	buildingID := g.enqueueBuilding(house)
+
+	assert.Equal(t, 80, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 20, g.resources[blueprints.Wood].Reserved)
+	assert.Equal(t, 4, g.resources[blueprints.Population].Amount)
+	assert.Equal(t, 2, g.resources[blueprints.Population].Reserved)
+	assert.Equal(t, 1, len(g.queue))
+
+	g.queue = g.queue[1:]
+
+	payload := protobuf.TimerFired{
+		Timestamp: timestamppb.Now(),
+		Data: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				KeyBuilding:          structpb.NewStringValue(string(blueprints.House)),
+				KeyDisableGenerators: structpb.NewBoolValue(true),
+				KeyId:                structpb.NewStringValue(buildingID.String()),
+			},
+		},
+	}
+
+	g.buildingCallback(&payload)
+
+	assert.Equal(t, 80, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 0, g.resources[blueprints.Wood].Reserved)
+	assert.Equal(t, 6, g.resources[blueprints.Population].Amount)
+	assert.Equal(t, 0, g.resources[blueprints.Population].Reserved)
+	assert.Equal(t, 1, len(g.buildings[house.ID].Completed))
+}
+
+func TestBuildingCallbackSettles(t *testing.T) {
+	now := time.Now()
+	g := New(clock.NewFake(now))
+	g.productionMode = config.ProductionModeLazy
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	g.updateLimits()
+
+	woodcutter, err := registry.GetBuilding(blueprints.Woodcutter)
+	assert.NoError(t, err)
+
+	woodcutterID := uuid.New()
+	g.buildings[woodcutter.ID].Completed[woodcutterID] = Building{
+		ID:             woodcutterID,
+		BlueprintID:    woodcutter.ID,
+		Name:           woodcutter.Name,
+		State:          protobuf.BuildingState_BuildingStateActive,
+		WorkersMaximum: 2,
+		WorkersCurrent: 2,
+		Timers:         NewTimerRegister(),
+		Settled: map[string]time.Time{
+			"generator:Wood": now.Add(-65 * time.Second),
+		},
+	}
+
+	warehouse, err := registry.GetBuilding(blueprints.Warehouse)
+	assert.NoError(t, err)
+
+	warehouseID := uuid.New()
+	g.buildings[warehouse.ID].Queue[warehouseID] = Building{
+		ID:             warehouseID,
+		BlueprintID:    warehouse.ID,
+		Name:           warehouse.Name,
+		State:          protobuf.BuildingState_BuildingStateInactive,
+		WorkersMaximum: 0,
+		WorkersCurrent: 0,
+		Completion:     now,
+	}
+
+	payload := protobuf.TimerFired{
+		Timestamp: timestamppb.New(now),
+		Data: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				KeyBuilding:          structpb.NewStringValue(string(blueprints.Warehouse)),
+				KeyDisableGenerators: structpb.NewBoolValue(true),
+				KeyId:                structpb.NewStringValue(warehouseID.String()),
+			},
+		},
+	}
+
+	g.buildingCallback(&payload)
+	assert.Equal(t, 200, g.resources[blueprints.Wood].Cap)
+
+	// The Wood generated before the warehouse was completed stopped at the previous cap
+	g.settleProduction(now)
+	assert.Equal(t, 100, g.resources[blueprints.Wood].Amount)
+}
+
+func TestCancelConstruction(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	house, err := registry.GetBuilding(blueprints.House)
+	assert.NoError(t, err)
+
+	buildingID := g.enqueueBuilding(house)
+
+	assert.NoError(t, g.cancelConstruction(buildingID))
+
+	assert.Equal(t, 0, len(g.queue))
+	assert.Equal(t, 0, len(g.buildings[house.ID].Queue))
+	assert.Equal(t, 90, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 0, g.resources[blueprints.Wood].Reserved)
+	assert.Equal(t, 6, g.resources[blueprints.Population].Amount)
+	assert.Equal(t, 0, g.resources[blueprints.Population].Reserved)
+
+	assert.Equal(t, BuildingNotFoundError{BuildingID: buildingID.String()}, g.cancelConstruction(buildingID))
+}
+
+func TestDemolish(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	warehouse, err := registry.GetBuilding(blueprints.Warehouse)
+	assert.NoError(t, err)
+
+	buildingID := uuid.New()
+	g.buildings[warehouse.ID].Completed[buildingID] = Building{
+		ID:          buildingID,
+		BlueprintID: warehouse.ID,
+		Name:        warehouse.Name,
+		State:       protobuf.BuildingState_BuildingStateActive,
+		Timers:      NewTimerRegister(),
+	}
+
+	g.updateLimits()
+	assert.Equal(t, 200, g.resources[blueprints.Wood].Cap)
+
+	g.resources[blueprints.Wood].Update(50)
+	assert.Equal(t, 150, g.resources[blueprints.Wood].Amount)
+
+	assert.NoError(t, g.demolishBuilding(buildingID))
+	assert.Equal(t, 0, len(g.buildings[warehouse.ID].Completed))
+	assert.Equal(t, 175, g.resources[blueprints.Wood].Amount)
+
+	g.updateLimits()
+	assert.Equal(t, 100, g.resources[blueprints.Wood].Cap)
+	assert.Equal(t, 100, g.resources[blueprints.Wood].Amount)
+
+	assert.Equal(t, BuildingNotFoundError{BuildingID: buildingID.String()}, g.demolishBuilding(buildingID))
+}
+
+func TestDemolishTimestamp(t *testing.T) {
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	start := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	s := NewSimulation(start)
+
+	res, err := s.grain.Demolish(&protobuf.DemolishRequest{BuildingID: uuid.New().String()}, s.grain.ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_Error, res.Status)
+	assert.Equal(t, start, res.Timestamp.AsTime())
+}
+
+func TestAssignWorkers(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	g.updateLimits()
+
+	woodcutter, err := registry.GetBuilding(blueprints.Woodcutter)
+	assert.NoError(t, err)
+
+	buildingID := uuid.New()
+	g.buildings[woodcutter.ID].Queue[buildingID] = Building{
+		ID:             buildingID,
+		BlueprintID:    woodcutter.ID,
+		Name:           woodcutter.Name,
+		State:          protobuf.BuildingState_BuildingStateQueued,
+		WorkersMaximum: woodcutter.WorkersMaximum,
+		Timers:         NewTimerRegister(),
+	}
+
+	assert.True(t, g.completeBuilding(woodcutter, buildingID))
+	assert.Equal(t, protobuf.BuildingState_BuildingStateInactive, g.buildings[woodcutter.ID].Completed[buildingID].State)
+
+	assert.Equal(t, InvalidWorkerAmountError{Workers: 3, Maximum: 2}, g.assignWorkers(buildingID, 3))
+	assert.Equal(t, BuildingNotFoundError{BuildingID: uuid.Nil.String()}, g.assignWorkers(uuid.Nil, 1))
+
+	assert.NoError(t, g.assignWorkers(buildingID, 1))
+	assert.Equal(t, protobuf.BuildingState_BuildingStateActive, g.buildings[woodcutter.ID].Completed[buildingID].State)
+	assert.Equal(t, 5, g.resources[blueprints.Population].Amount)
+	assert.Equal(t, 1, g.resources[blueprints.Population].Reserved)
+
+	g.resources[blueprints.Wood].Amount = 0
+	g.generatorCallback(&protobuf.TimerFired{
+		Timestamp: timestamppb.Now(),
+		Data: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				KeyId:       structpb.NewStringValue(buildingID.String()),
+				KeyResource: structpb.NewStringValue(string(blueprints.Wood)),
+				KeyAmount:   structpb.NewNumberValue(4),
+			},
+		},
+	})
+	assert.Equal(t, 2, g.resources[blueprints.Wood].Amount)
+
+	assert.NoError(t, g.assignWorkers(buildingID, 0))
+	assert.Equal(t, protobuf.BuildingState_BuildingStateInactive, g.buildings[woodcutter.ID].Completed[buildingID].State)
+	assert.Equal(t, 6, g.resources[blueprints.Population].Amount)
+	assert.Equal(t, 0, g.resources[blueprints.Population].Reserved)
+}
+
+func TestSetBuildingActive(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	g.tickReservations = NewTickReservations()
+
+	warehouse, err := registry.GetBuilding(blueprints.Warehouse)
+	assert.NoError(t, err)
+
+	buildingID := uuid.New()
+	g.buildings[warehouse.ID].Completed[buildingID] = Building{
+		ID:          buildingID,
+		BlueprintID: warehouse.ID,
+		Name:        warehouse.Name,
+		State:       protobuf.BuildingState_BuildingStateActive,
+		Timers:      NewTimerRegister(),
+	}
+
+	assert.NoError(t, g.setBuildingActive(buildingID, false))
+	assert.True(t, g.buildings[warehouse.ID].Completed[buildingID].Paused)
+	assert.Equal(t, protobuf.BuildingState_BuildingStateInactive, g.buildings[warehouse.ID].Completed[buildingID].State)
+	assert.Equal(t, 0, g.buildings[warehouse.ID].Completed[buildingID].scaleOutput(10))
+
+	assert.NoError(t, g.setBuildingActive(buildingID, true))
+	assert.False(t, g.buildings[warehouse.ID].Completed[buildingID].Paused)
+	assert.Equal(t, protobuf.BuildingState_BuildingStateActive, g.buildings[warehouse.ID].Completed[buildingID].State)
+
+	assert.Equal(t, BuildingNotFoundError{BuildingID: uuid.Nil.String()}, g.setBuildingActive(uuid.Nil, false))
+}
+
+func TestReleaseTickReservation(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	g.tickReservations = NewTickReservations()
+
+	timerID := uuid.New()
+	res, _ := g.Reserve(&protobuf.ReserveRequest{
+		TimerID: timerID.String(),
+		Resources: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				string(blueprints.Wood): structpb.NewNumberValue(5),
+			},
+		},
+	}, nil)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+	assert.Equal(t, 95, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 5, g.resources[blueprints.Wood].Reserved)
+
+	g.releaseTickReservation(timerID)
+	assert.Equal(t, 100, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 0, g.resources[blueprints.Wood].Reserved)
+
+	// Releasing twice must not return the resources again
+	g.releaseTickReservation(timerID)
+	assert.Equal(t, 100, g.resources[blueprints.Wood].Amount)
+}
+
+func TestUpgrade(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	warehouse, err := registry.GetBuilding(blueprints.Warehouse)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, warehouse.MaxLevel())
+	assert.Equal(t, "10s", warehouse.AtLevel(1).BuildTime)
+	assert.Equal(t, "20s", warehouse.AtLevel(2).BuildTime)
+	assert.Equal(t, 80, warehouse.AtLevel(2).Cost[0].Amount)
+	assert.Equal(t, 250, warehouse.AtLevel(2).Stores[blueprints.Stone])
+
+	buildingID := uuid.New()
+	g.buildings[warehouse.ID].Completed[buildingID] = Building{
+		ID:          buildingID,
+		BlueprintID: warehouse.ID,
+		Name:        warehouse.Name,
+		State:       protobuf.BuildingState_BuildingStateActive,
+		Timers:      NewTimerRegister(),
+		Level:       1,
+		Upgrade: &Upgrade{
+			Level:             2,
+			ReservedResources: g.reserveCost(warehouse.AtLevel(2).Cost),
+		},
+	}
+
+	g.updateLimits()
+	assert.Equal(t, 200, g.resources[blueprints.Stone].Cap)
+	assert.Equal(t, 20, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 80, g.resources[blueprints.Wood].Reserved)
+
+	building, _, ok := g.completeUpgrade(buildingID)
+	assert.True(t, ok)
+	assert.Equal(t, 2, building.Level)
+	assert.Nil(t, building.Upgrade)
+	assert.Equal(t, 0, g.resources[blueprints.Wood].Reserved)
+
+	// Formulas using the count and the per-level counts both see the upgraded building
+	g.updateLimits()
+	assert.Equal(t, 200, g.resources[blueprints.Wood].Cap)
+	assert.Equal(t, 350, g.resources[blueprints.Stone].Cap)
+
+	_, _, ok = g.completeUpgrade(buildingID)
+	assert.False(t, ok)
+}
+
+func TestPrerequisites(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	g.research = NewResearchRegister()
+
+	res, err := g.StartBuilding(&protobuf.StartBuildingRequest{
+		Name:   string(blueprints.Lumberyard),
+		Amount: 1,
+	}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_Error, res.Status)
+	assert.Equal(t, "Lumberyard is locked, requires Carpentry", res.Error)
+	assert.Equal(t, []interface{}{"Carpentry"}, res.Reason.AsMap()["missing_research"])
+
+	carpentry, err := registry.GetResearch("Carpentry")
+	assert.NoError(t, err)
+
+	assert.Equal(t, LockedError{
+		Name:             "Carpentry",
+		MissingBuildings: map[blueprints.BuildingName]int{blueprints.Warehouse: 1},
+		MissingResearch:  []blueprints.ResearchName{},
+	}, g.checkPrerequisites(carpentry.Name.String(), carpentry.Requires))
+
+	warehouse, err := registry.GetBuilding(blueprints.Warehouse)
+	assert.NoError(t, err)
+
+	buildingID := uuid.New()
+	g.buildings[warehouse.ID].Completed[buildingID] = Building{
+		ID:          buildingID,
+		BlueprintID: warehouse.ID,
+		Name:        warehouse.Name,
+		State:       protobuf.BuildingState_BuildingStateActive,
+		Timers:      NewTimerRegister(),
+	}
+
+	assert.NoError(t, g.checkPrerequisites(carpentry.Name.String(), carpentry.Requires))
+
+	g.research.InProgress[carpentry.Name] = ResearchOrder{
+		ReservedResources: g.reserveCost(carpentry.Cost),
+	}
+
+	g.updateLimits()
+	assert.Equal(t, 200, g.resources[blueprints.Planks].Cap)
+
+	assert.True(t, g.completeResearch(carpentry.Name))
+	assert.False(t, g.completeResearch(carpentry.Name))
+	assert.True(t, g.researched(carpentry.Name))
+	assert.Equal(t, 90, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 0, g.resources[blueprints.Wood].Reserved)
+
+	g.updateLimits()
+	assert.Equal(t, 250, g.resources[blueprints.Planks].Cap)
+
+	lumberyard, err := registry.GetBuilding(blueprints.Lumberyard)
+	assert.NoError(t, err)
+	assert.NoError(t, g.checkPrerequisites(lumberyard.Name.String(), lumberyard.Requires))
+}
+
+func TestLazyProduction(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+	g.productionMode = config.ProductionModeLazy
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	g.updateLimits()
+
+	now := time.Now()
+
+	woodcutter, err := registry.GetBuilding(blueprints.Woodcutter)
+	assert.NoError(t, err)
+
+	woodcutterID := uuid.New()
+	g.buildings[woodcutter.ID].Completed[woodcutterID] = Building{
+		ID:             woodcutterID,
+		BlueprintID:    woodcutter.ID,
+		Name:           woodcutter.Name,
+		State:          protobuf.BuildingState_BuildingStateActive,
+		WorkersMaximum: 2,
+		WorkersCurrent: 2,
+		Timers:         NewTimerRegister(),
+		Settled: map[string]time.Time{
+			"generator:Wood": now.Add(-65 * time.Second),
+		},
+	}
+
+	lumberyard, err := registry.GetBuilding(blueprints.Lumberyard)
+	assert.NoError(t, err)
+
+	lumberyardID := uuid.New()
+	g.buildings[lumberyard.ID].Completed[lumberyardID] = Building{
+		ID:             lumberyardID,
+		BlueprintID:    lumberyard.ID,
+		Name:           lumberyard.Name,
+		State:          protobuf.BuildingState_BuildingStateActive,
+		WorkersMaximum: 2,
+		WorkersCurrent: 2,
+		Timers:         NewTimerRegister(),
+		Settled: map[string]time.Time{
+			"transformer:Planks": now.Add(-100 * time.Second),
+		},
+	}
+
+	// 3 generator ticks add 9 Wood, then the 22 Wood available are enough for 4 of the 10 transformer ticks
+	g.resources[blueprints.Wood].Amount = 13
+	g.settleProduction(now)
+
+	assert.Equal(t, 2, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 4, g.resources[blueprints.Planks].Amount)
+	assert.Equal(t, now.Add(-5*time.Second), g.buildings[woodcutter.ID].Completed[woodcutterID].Settled["generator:Wood"])
+	assert.Equal(t, now, g.buildings[lumberyard.ID].Completed[lumberyardID].Settled["transformer:Planks"])
+
+	// Settling again without a whole tick passing doesn't produce anything
+	g.settleProduction(now.Add(time.Second))
+	assert.Equal(t, 2, g.resources[blueprints.Wood].Amount)
+
+	// Generated Wood stops at the cap, so the transformer can only run 20 times on the 100 Wood stored
+	g.resources[blueprints.Wood].Amount = 99
+	g.settleProduction(now.Add(time.Hour))
+	assert.Equal(t, 0, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 24, g.resources[blueprints.Planks].Amount)
+}
+
+func TestFireLog(t *testing.T) {
+	g := New(clock.NewFake(time.Now()))
+	g.fires = NewFireLog()
+
+	applied := 0
+	deliver := g.deliver(&Callback{
+		Name:    CallbackGenerators,
+		Subject: "test",
+		Method:  func(*protobuf.TimerFired) { applied++ },
+	})
+
+	timerID := uuid.New().String()
+
+	// Redelivered and out of order fires are only applied once
+	for _, sequence := range []int64{1, 2, 2, 1, 3} {
+		deliver(&protobuf.TimerFired{TimerID: timerID, Sequence: sequence})
+	}
+
+	assert.Equal(t, 3, applied)
+
+	// Fires from before sequences existed can't be told apart, so they are always applied
+	deliver(&protobuf.TimerFired{TimerID: timerID})
+	deliver(&protobuf.TimerFired{TimerID: timerID})
+
+	assert.Equal(t, 5, applied)
+
+	// Old entries are dropped once they can't be redelivered anymore
+	g.fires.Record(uuid.New().String(), 1, time.Now().Add(2*fireLogRetention))
+	assert.Equal(t, 1, len(g.fires.Timers))
+}
+
+func TestMigration(t *testing.T) {
+	now := time.Now()
+	g := New(clock.NewFake(now))
+	g.productionMode = config.ProductionModeLazy
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	g.revision = registry.Revision()
+	g.updateLimits()
+
+	woodcutter, err := registry.GetBuilding(blueprints.Woodcutter)
+	assert.NoError(t, err)
+
+	wood, err := registry.GetResource(blueprints.Wood.String())
+	assert.NoError(t, err)
+
+	woodcutterID := uuid.New()
+	g.buildings[woodcutter.ID].Completed[woodcutterID] = Building{
+		ID:             woodcutterID,
+		BlueprintID:    woodcutter.ID,
+		Name:           woodcutter.Name,
+		State:          protobuf.BuildingState_BuildingStateActive,
+		WorkersMaximum: 2,
+		WorkersCurrent: 2,
+		Timers:         NewTimerRegister(),
+		Settled: map[string]time.Time{
+			"generator:Wood": now.Add(-65 * time.Second),
+		},
+	}
+
+	g.resources[blueprints.Wood].Amount = 50
+
+	// Nothing happens until a blueprint changes
+	g.reconcile()
+	assert.Equal(t, 50, g.resources[blueprints.Wood].Amount)
+
+	// Resources introduced after the inventory was created get a register
+	delete(g.resources, blueprints.Planks)
+
+	faster := *woodcutter
+	faster.Version = 0
+	faster.Generates = []blueprints.Generator{{Name: blueprints.Wood, Amount: 5, TickLength: "10s"}}
+	assert.NoError(t, registry.Push(&faster))
+	assert.Equal(t, woodcutter.Version+1, faster.Version)
+
+	larger := *wood
+	larger.CapFormula = "return 500"
+	assert.NoError(t, registry.Push(&larger))
+
+	t.Cleanup(func() {
+		assert.NoError(t, registry.Push(woodcutter))
+		assert.NoError(t, registry.Push(wood))
+	})
+
+	g.reconcile()
+
+	// 3 ticks passed with the version of the blueprint the woodcutter was producing with, and the
+	// generator restarts from now with the new one
+	assert.Equal(t, 59, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 500, g.resources[blueprints.Wood].Cap)
+	assert.Equal(t, larger.Version, g.resources[blueprints.Wood].Version)
+	assert.Equal(t, faster.Version, g.buildings[woodcutter.ID].Version)
+	assert.Equal(t, now, g.buildings[woodcutter.ID].Completed[woodcutterID].Settled["generator:Wood"])
+
+	assert.Contains(t, g.resources, blueprints.Planks)
+	assert.Equal(t, 0, g.resources[blueprints.Planks].Amount)
+	assert.Equal(t, 100, g.resources[blueprints.Planks].Cap)
+}
+
+func TestMigrationDeletions(t *testing.T) {
+	now := time.Now()
+	g := New(clock.NewFake(now))
+	g.productionMode = config.ProductionModeLazy
+
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	var assetError error
+
+	g.buildings, assetError = g.getStartingBuildings()
+	assert.NoError(t, assetError)
+
+	g.resources, assetError = g.getStartingResources()
+	assert.NoError(t, assetError)
+
+	g.revision = registry.Revision()
+	g.updateLimits()
+
+	house, err := registry.GetBuilding(blueprints.House)
+	assert.NoError(t, err)
+
+	g.resources[blueprints.Wood].Amount = 50
+	g.resources["Clay"] = &ResourceRegister{mx: &sync.Mutex{}, Name: "Clay", Amount: 10} // nolint:exhaustruct
+
+	// The buildings of a blueprint that was deleted from the registry, one producing and one queued
+	assert.NoError(t, g.reserveWorkers(2))
+
+	quarryID, producingID, queuedID := uuid.New(), uuid.New(), uuid.New()
+	g.buildings[quarryID] = &BuildingRegister{
+		mx:          &sync.Mutex{},
+		BlueprintID: quarryID,
+		Name:        "Quarry",
+		Completed: map[uuid.UUID]Building{
+			producingID: {
+				ID:             producingID,
+				BlueprintID:    quarryID,
+				Name:           "Quarry",
+				State:          protobuf.BuildingState_BuildingStateActive,
+				WorkersMaximum: 2,
+				WorkersCurrent: 2,
+				Timers:         NewTimerRegister(),
+				Settled: map[string]time.Time{
+					"generator:Wood": now.Add(-65 * time.Second),
+				},
+			},
+		},
+		Queue: map[uuid.UUID]Building{
+			queuedID: {ID: queuedID, ReservedResources: g.reserveCost(house.Cost)}, // nolint:exhaustruct
+		},
+		Production: map[int]Production{
+			1: {Generates: []blueprints.Generator{{Name: blueprints.Wood, Amount: 1, TickLength: "10s"}}}, // nolint:exhaustruct
+		},
+	}
+	g.queue = append(g.queue, ConstructionOrder{BlueprintID: quarryID, BuildingID: queuedID})
+
+	g.revision--
+	g.reconcile()
+
+	// The production up to the deletion is kept, the rest of the buildings is gone and refunded
+	assert.NotContains(t, g.buildings, quarryID)
+	assert.Empty(t, g.queue)
+	assert.Equal(t, 56, g.resources[blueprints.Wood].Amount)
+	assert.Equal(t, 0, g.resources[blueprints.Wood].Reserved)
+	assert.Equal(t, 6, g.resources[blueprints.Population].Amount)
+	assert.Equal(t, 0, g.resources[blueprints.Population].Reserved)
+	assert.NotContains(t, g.resources, blueprints.ResourceName("Clay"))
+
+	// The buildings of the other blueprints are left alone
+	assert.Contains(t, g.buildings, house.ID)
+}
@@ -0,0 +1,208 @@
+package inventory
+
+import (
+	"sync"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/0xa1-red/empires-of-avalon/transport"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slog"
+)
+
+// subscribeToRegistryUpdates migrates the inventory whenever a blueprint changes. The updates go
+// through the mailbox, so the migration doesn't run concurrently with the requests to the grain.
+func (g *Grain) subscribeToRegistryUpdates() error {
+	conn, err := transport.Get()
+	if err != nil {
+		return err
+	}
+
+	sub, err := conn.Subscribe(registry.SubjectUpdates, func(update *protobuf.RegistryUpdate) {
+		g.ctx.Send(g.ctx.Self(), update)
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.Debug("subscribed to callback subject", "subject", registry.SubjectUpdates)
+
+	g.subscriptions[CallbackRegistry] = sub
+
+	return nil
+}
+
+// reconcile migrates the inventory if a blueprint changed since it last did
+func (g *Grain) reconcile() {
+	if registry.Revision() == g.revision {
+		return
+	}
+
+	g.migrate()
+}
+
+// migrate brings the registers up to date with the blueprints in the registry. Resources introduced
+// since the registers were built are added with their starting amount, and the production of buildings
+// built against an earlier version of their blueprint is restarted with the current one. Registers of
+// deleted blueprints are removed. Caps are recomputed at the end, as any of the changes can affect them.
+func (g *Grain) migrate() {
+	g.revision = registry.Revision()
+
+	resources, err := registry.GetResources()
+	if err != nil {
+		slog.Error("failed to retrieve resource blueprints", err)
+		return
+	}
+
+	for name, blueprint := range resources {
+		register, ok := g.resources[name]
+		if !ok {
+			g.resources[name] = &ResourceRegister{
+				mx:         &sync.Mutex{},
+				Name:       name,
+				Amount:     blueprint.StartingAmount,
+				Reserved:   0,
+				Cap:        0,
+				Bonus:      0,
+				CapFormula: blueprint.CapFormula,
+				Version:    blueprint.Version,
+			}
+
+			slog.Info("added resource register", "name", name, "version", blueprint.Version)
+
+			continue
+		}
+
+		if register.Version == blueprint.Version {
+			continue
+		}
+
+		register.mx.Lock()
+		register.CapFormula = blueprint.CapFormula
+		register.Version = blueprint.Version
+		register.mx.Unlock()
+
+		slog.Info("migrated resource register", "name", name, "version", blueprint.Version)
+	}
+
+	buildings, err := registry.GetBuildings()
+	if err != nil {
+		slog.Error("failed to retrieve building blueprints", err)
+		return
+	}
+
+	// Production up to now is settled with the versions of the blueprints the registers were built with,
+	// before any of them is migrated. Registers persisted before they kept their production have nothing
+	// but the current blueprints to settle with, so their unsettled period is paid at the current version.
+	g.settleProduction(g.clock.Now())
+
+	// Blueprints missing from the registry were deleted, unless there are none of their kind at all,
+	// as the registry wasn't loaded yet then
+	current := make(map[uuid.UUID]struct{}, len(buildings))
+	for _, blueprint := range buildings {
+		current[blueprint.ID] = struct{}{}
+	}
+
+	for blueprintID, register := range g.buildings {
+		if _, ok := current[blueprintID]; !ok && len(current) > 0 {
+			g.removeRegister(blueprintID, register)
+		}
+	}
+
+	for name := range g.resources {
+		if _, ok := resources[name]; !ok && len(resources) > 0 {
+			delete(g.resources, name)
+			slog.Info("removed resource register of deleted blueprint", "name", name)
+		}
+	}
+
+	for _, blueprint := range buildings {
+		register, ok := g.buildings[blueprint.ID]
+		if !ok {
+			// Cap formulas may refer to buildings the inventory has none of yet
+			g.buildings[blueprint.ID] = &BuildingRegister{
+				mx:          &sync.Mutex{},
+				Name:        blueprint.Name,
+				Completed:   make(map[uuid.UUID]Building),
+				Queue:       make(map[uuid.UUID]Building),
+				BlueprintID: blueprint.ID,
+				Version:     blueprint.Version,
+				Production:  productionOf(blueprint),
+			}
+
+			continue
+		}
+
+		if register.Version == blueprint.Version {
+			continue
+		}
+
+		g.restartProduction(register, blueprint)
+	}
+
+	g.updateLimits()
+}
+
+// restartProduction stops the generators and transformers of the completed buildings of a register
+// and starts them again with the given blueprint. Paused buildings are left alone, they pick up the
+// blueprint once they're resumed.
+func (g *Grain) restartProduction(register *BuildingRegister, blueprint *blueprints.Building) {
+	register.mx.Lock()
+
+	buildingIDs := make([]uuid.UUID, 0, len(register.Completed))
+	for buildingID, building := range register.Completed {
+		if !building.Paused {
+			buildingIDs = append(buildingIDs, buildingID)
+		}
+	}
+
+	register.Version = blueprint.Version
+	register.Production = productionOf(blueprint)
+	register.mx.Unlock()
+
+	for _, buildingID := range buildingIDs {
+		building, ok := g.completedBuilding(buildingID, blueprint)
+		if !ok {
+			continue
+		}
+
+		g.stopBuildingTimers(building)
+		g.startBuildingGenerators(buildingID, blueprint)
+		g.startBuildingTransformers(buildingID, blueprint)
+	}
+
+	slog.Info("migrated building register", "name", blueprint.Name, "version", blueprint.Version, "restarted", len(buildingIDs))
+}
+
+// removeRegister drops the buildings of a deleted blueprint. The production and upgrades of the completed
+// buildings are stopped and their workers released, the buildings waiting for their construction are
+// cancelled and refunded in full.
+func (g *Grain) removeRegister(blueprintID uuid.UUID, register *BuildingRegister) {
+	register.mx.Lock()
+	completed := register.Completed
+	queued := register.Queue
+	register.mx.Unlock()
+
+	for buildingID, building := range completed {
+		g.stopBuildingTimers(building)
+		g.cancelUpgrade(building)
+
+		if err := g.reserveWorkers(-building.WorkersCurrent); err != nil {
+			slog.Warn("failed to release workers", "error", err, "id", buildingID.String())
+		}
+	}
+
+	for buildingID, build := range queued {
+		if build.TimerID != uuid.Nil {
+			g.stopTimer(build.TimerID)
+		}
+
+		g.removeOrder(buildingID)
+		g.refundReserved(build.ReservedResources, 100)
+	}
+
+	delete(g.buildings, blueprintID)
+
+	slog.Info("removed building register of deleted blueprint", "name", register.Name, "completed", len(completed), "queued", len(queued))
+}
@@ -0,0 +1,52 @@
+package inventory
+
+import (
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slog"
+)
+
+// setBuildingActive pauses or resumes a completed building. Pausing stops the generator and transformer
+// timers of the building, resuming starts them again.
+func (g *Grain) setBuildingActive(buildingID uuid.UUID, active bool) error {
+	for _, register := range g.buildings {
+		register.mx.Lock()
+		building, ok := register.Completed[buildingID]
+		register.mx.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		if building.Paused == !active {
+			return nil
+		}
+
+		if !active {
+			g.stopBuildingTimers(building)
+		}
+
+		building.Paused = !active
+		building.State = building.completedState()
+
+		register.mx.Lock()
+		register.Completed[buildingID] = building
+		register.mx.Unlock()
+
+		if active {
+			blueprint, err := registry.GetBuilding(register.Name)
+			if err != nil {
+				return err
+			}
+
+			g.startBuildingGenerators(buildingID, blueprint)
+			g.startBuildingTransformers(buildingID, blueprint)
+		}
+
+		slog.Info("changed building state", "name", string(register.Name), "id", buildingID.String(), "paused", building.Paused)
+
+		return nil
+	}
+
+	return BuildingNotFoundError{BuildingID: buildingID.String()}
+}
@@ -23,6 +23,10 @@ func (g *Grain) Encode() ([]byte, error) {
 
 	data["buildings"] = g.buildings
 	data["resources"] = g.resources
+	data["queue"] = g.queue
+	data["tick_reservations"] = g.tickReservations.Timers
+	data["research"] = g.research
+	data["fires"] = g.fires.Timers
 	encode["data"] = data
 	encode["identity"] = g.ctx.Identity()
 
@@ -49,10 +53,31 @@ func (g *Grain) Decode(b []byte) error {
 	g.buildings = m["buildings"].(map[uuid.UUID]*BuildingRegister)
 	g.resources = m["resources"].(map[blueprints.ResourceName]*ResourceRegister)
 
+	if queue, ok := m["queue"].([]ConstructionOrder); ok {
+		g.queue = queue
+	}
+
+	if research, ok := m["research"].(*ResearchRegister); ok {
+		research.mx = &sync.Mutex{}
+		g.research = research
+	}
+
+	if fires, ok := m["fires"].(map[string]AppliedFire); ok {
+		g.fires = NewFireLog()
+		g.fires.Timers = fires
+	}
+
 	for _, r := range g.resources {
 		r.mx = &sync.Mutex{}
 	}
 
+	// Transformer timers reserve their current tick again once they are restored or restarted
+	if reservations, ok := m["tick_reservations"].(map[uuid.UUID]map[blueprints.ResourceName]int); ok {
+		g.tickReservations = NewTickReservations()
+		g.tickReservations.Timers = reservations
+		g.releaseTickReservations()
+	}
+
 	for blueprintID, b := range g.buildings {
 		b.mx = &sync.Mutex{}
 		if len(b.Completed) == 0 {
@@ -64,15 +89,27 @@ func (g *Grain) Decode(b []byte) error {
 		blueprint, err := registry.GetBuilding(b.Name)
 		if err != nil {
 			slog.Error("failed to retrieve blueprint from registry", err, "blueprint_id", blueprintID)
+			continue
+		}
+
+		// Buildings of an outdated blueprint are restarted by the migration below
+		if b.Version != blueprint.Version {
+			continue
 		}
 
-		for buildingID := range b.Completed {
+		for buildingID, building := range b.Completed {
+			// Paused buildings don't produce, and running production timers are restored on their own
+			if building.Paused || building.hasTimers() {
+				continue
+			}
+
 			g.startBuildingGenerators(buildingID, blueprint)
 			g.startBuildingTransformers(buildingID, blueprint)
 		}
 	}
 
-	g.updateLimits()
+	// Blueprints may have changed while the inventory was persisted
+	g.migrate()
 
 	return nil
 }
@@ -100,9 +137,13 @@ func (g *Grain) Restore(req *protobuf.RestoreRequest, ctx cluster.GrainContext)
 }
 
 func init() {
-	buildingRegisters := make(map[blueprints.BuildingName]*BuildingRegister)
+	buildingRegisters := make(map[uuid.UUID]*BuildingRegister)
 	resourceRegisters := make(map[blueprints.ResourceName]*ResourceRegister)
 
 	gob.Register(buildingRegisters)
 	gob.Register(resourceRegisters)
+	gob.Register(make([]ConstructionOrder, 0))
+	gob.Register(make(map[uuid.UUID]map[blueprints.ResourceName]int))
+	gob.Register(NewResearchRegister())
+	gob.Register(make(map[string]AppliedFire))
 }
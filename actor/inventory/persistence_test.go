@@ -0,0 +1,69 @@
+package inventory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	if err := setupRegistry(); err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+
+	g := New(clk)
+	g.setup(simulationContext{}) // nolint:exhaustruct
+
+	woodcutter, err := registry.GetBuilding(blueprints.Woodcutter)
+	assert.NoError(t, err)
+
+	paused, running := uuid.New(), uuid.New()
+
+	for _, id := range []uuid.UUID{paused, running} {
+		g.buildings[woodcutter.ID].Completed[id] = Building{ // nolint:exhaustruct
+			ID:             id,
+			BlueprintID:    woodcutter.ID,
+			Name:           woodcutter.Name,
+			State:          protobuf.BuildingState_BuildingStateActive,
+			WorkersMaximum: woodcutter.WorkersMaximum,
+			WorkersCurrent: woodcutter.WorkersMaximum,
+			Timers:         NewTimerRegister(),
+			Paused:         id == paused,
+			Level:          1,
+		}
+	}
+
+	b, err := g.Encode()
+	assert.NoError(t, err)
+
+	timers := &recordingTimers{} // nolint:exhaustruct
+
+	restored := New(clk)
+	restored.productionMode = config.ProductionModeTimers
+	restored.setup(simulationContext{}) // nolint:exhaustruct
+	restored.timerClient = timers.client
+
+	assert.NoError(t, restored.Decode(b))
+
+	register := restored.buildings[woodcutter.ID]
+	if !assert.NotNil(t, register) {
+		return
+	}
+
+	assert.True(t, register.Completed[paused].Paused)
+	assert.False(t, register.Completed[paused].hasTimers())
+	assert.Equal(t, 0, timers.startedFor(paused))
+
+	assert.False(t, register.Completed[running].Paused)
+	assert.True(t, register.Completed[running].hasTimers())
+	assert.Equal(t, len(woodcutter.Generates), timers.startedFor(running))
+}
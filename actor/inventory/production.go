@@ -0,0 +1,218 @@
+package inventory
+
+import (
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	"golang.org/x/exp/slog"
+)
+
+// lazyProduction reports whether generators and transformers are settled by the inventory
+// on demand instead of running on their own timer grains
+func (g *Grain) lazyProduction() bool {
+	return g.productionMode == config.ProductionModeLazy
+}
+
+// Production is what a building produces at a level of its blueprint
+type Production struct {
+	Generates  []blueprints.Generator
+	Transforms []blueprints.Transformer
+}
+
+// productionOf returns what the buildings of a blueprint produce at each of its levels
+func productionOf(blueprint *blueprints.Building) map[int]Production {
+	production := make(map[int]Production)
+
+	for level := 1; level <= blueprint.MaxLevel(); level++ {
+		leveled := blueprint.AtLevel(level)
+		production[level] = Production{
+			Generates:  leveled.Generates,
+			Transforms: leveled.Transforms,
+		}
+	}
+
+	return production
+}
+
+func generatorKey(generator blueprints.Generator) string {
+	return "generator:" + generator.Name.String()
+}
+
+func transformerKey(transformer blueprints.Transformer) string {
+	return "transformer:" + transformer.Name
+}
+
+// startSettlement starts the production clock of the given generators and transformers.
+// Clocks that are already running are kept, so restored inventories pick up where they left off.
+func (g *Grain) startSettlement(building Building, keys []string, now time.Time) {
+	register, ok := g.buildings[building.BlueprintID]
+	if !ok {
+		return
+	}
+
+	register.mx.Lock()
+	defer register.mx.Unlock()
+
+	if building.Settled == nil {
+		building.Settled = make(map[string]time.Time)
+		register.Completed[building.ID] = building
+	}
+
+	for _, key := range keys {
+		if _, ok := building.Settled[key]; !ok {
+			building.Settled[key] = now
+		}
+	}
+}
+
+// stopSettlement drops the production clocks of a building
+func (building Building) stopSettlement() {
+	for key := range building.Settled {
+		delete(building.Settled, key)
+	}
+}
+
+// settleProduction adds the output of every whole tick that passed since the last settlement.
+// Generators are settled before transformers, so transformers can use everything generated in the same period.
+func (g *Grain) settleProduction(now time.Time) {
+	if !g.lazyProduction() {
+		return
+	}
+
+	g.settle(now, func(building Building, production Production) {
+		for _, generator := range production.Generates {
+			ticks := building.settleTicks(generatorKey(generator), generator.TickLength, now)
+			if ticks == 0 {
+				continue
+			}
+
+			resource, ok := g.resources[generator.Name]
+			if !ok {
+				slog.Warn("generated resource not found", "resource", generator.Name)
+				continue
+			}
+
+			resource.Update(building.scaleOutput(generator.Amount) * ticks)
+		}
+	})
+
+	g.settle(now, func(building Building, production Production) {
+		for _, transformer := range production.Transforms {
+			ticks := building.settleTicks(transformerKey(transformer), transformer.TickLength, now)
+			if ticks == 0 {
+				continue
+			}
+
+			runs := g.transform(building, transformer, ticks)
+			slog.Debug("settled transformer", "name", transformer.Name, "ticks", ticks, "runs", runs)
+		}
+	})
+}
+
+// settle calls fn with every producing building and what it produces at its level
+func (g *Grain) settle(now time.Time, fn func(building Building, production Production)) {
+	for _, register := range g.buildings {
+		production := register.Production
+
+		// Registers persisted before they kept their production settle with the current blueprint
+		if production == nil && len(register.Completed) > 0 {
+			blueprint, err := registry.GetBuilding(register.Name)
+			if err != nil {
+				slog.Warn("failed to retrieve blueprint from registry", "name", register.Name)
+				continue
+			}
+
+			production = productionOf(blueprint)
+		}
+
+		register.mx.Lock()
+		for _, building := range register.Completed {
+			if building.Paused || len(building.Settled) == 0 {
+				continue
+			}
+
+			fn(building, production[building.CurrentLevel()])
+		}
+		register.mx.Unlock()
+	}
+}
+
+// settleTicks returns the number of whole ticks passed since the last settlement and moves the clock forward
+func (building Building) settleTicks(key, tickLength string, now time.Time) int {
+	last, ok := building.Settled[key]
+	if !ok {
+		return 0
+	}
+
+	tick, err := time.ParseDuration(tickLength)
+	if err != nil || tick <= 0 {
+		slog.Warn("invalid tick length", "key", key, "tick_length", tickLength)
+		return 0
+	}
+
+	ticks := int(now.Sub(last) / tick)
+	if ticks <= 0 {
+		return 0
+	}
+
+	building.Settled[key] = last.Add(time.Duration(ticks) * tick)
+
+	return ticks
+}
+
+// transform runs a transformer for as many of the given ticks as its inputs allow and returns the number of runs.
+// Like the timer based transformers, every run needs the full cost to be available, but understaffed buildings
+// only use up their share of it.
+func (g *Grain) transform(building Building, transformer blueprints.Transformer, ticks int) int {
+	runs := ticks
+
+	for _, cost := range transformer.Cost {
+		resource, ok := g.resources[cost.Resource]
+		if !ok {
+			return 0
+		}
+
+		resource.mx.Lock()
+		available := resource.Amount
+		resource.mx.Unlock()
+
+		if available < cost.Amount {
+			return 0
+		}
+
+		consumed := building.scaleOutput(cost.Amount)
+		if cost.Temporary || consumed == 0 {
+			continue
+		}
+
+		if possible := (available-cost.Amount)/consumed + 1; possible < runs {
+			runs = possible
+		}
+	}
+
+	for _, cost := range transformer.Cost {
+		if cost.Temporary {
+			continue
+		}
+
+		resource := g.resources[cost.Resource]
+
+		resource.mx.Lock()
+		resource.Amount -= building.scaleOutput(cost.Amount) * runs
+		resource.mx.Unlock()
+	}
+
+	for _, result := range transformer.Result {
+		resource, ok := g.resources[result.Resource]
+		if !ok {
+			slog.Warn("transformed resource not found", "resource", result.Resource)
+			continue
+		}
+
+		resource.Update(building.scaleOutput(result.Amount) * runs)
+	}
+
+	return runs
+}
@@ -0,0 +1,279 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ResearchRegister keeps track of the finished and running research of an inventory
+type ResearchRegister struct {
+	mx *sync.Mutex
+
+	Completed  map[blueprints.ResearchName]time.Time
+	InProgress map[blueprints.ResearchName]ResearchOrder
+}
+
+// ResearchOrder is a research waiting for its timer
+type ResearchOrder struct {
+	TimerID           uuid.UUID
+	Completion        time.Time
+	ReservedResources []ReservedResource
+}
+
+func NewResearchRegister() *ResearchRegister {
+	return &ResearchRegister{
+		mx:         &sync.Mutex{},
+		Completed:  make(map[blueprints.ResearchName]time.Time),
+		InProgress: make(map[blueprints.ResearchName]ResearchOrder),
+	}
+}
+
+// researched reports whether every given research is finished
+func (g *Grain) researched(names ...blueprints.ResearchName) bool {
+	if len(names) == 0 {
+		return true
+	}
+
+	if g.research == nil {
+		return false
+	}
+
+	g.research.mx.Lock()
+	defer g.research.mx.Unlock()
+
+	for _, name := range names {
+		if _, ok := g.research.Completed[name]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkPrerequisites returns a LockedError listing everything the inventory is missing to unlock a blueprint
+func (g *Grain) checkPrerequisites(name string, prerequisites blueprints.Prerequisites) error {
+	locked := LockedError{
+		Name:             name,
+		MissingBuildings: make(map[blueprints.BuildingName]int),
+		MissingResearch:  make([]blueprints.ResearchName, 0),
+	}
+
+	for buildingName, required := range prerequisites.Buildings {
+		count := 0
+
+		for _, register := range g.buildings {
+			if register.Name == buildingName {
+				count = len(register.Completed)
+			}
+		}
+
+		if count < required {
+			locked.MissingBuildings[buildingName] = required
+		}
+	}
+
+	for _, research := range prerequisites.Research {
+		if !g.researched(research) {
+			locked.MissingResearch = append(locked.MissingResearch, research)
+		}
+	}
+
+	if len(locked.MissingBuildings) == 0 && len(locked.MissingResearch) == 0 {
+		return nil
+	}
+
+	return locked
+}
+
+// startResearch reserves the cost of a research and starts its timer
+func (g *Grain) startResearch(ctx context.Context, research *blueprints.Research) error {
+	if g.researched(research.Name) {
+		return ResearchCompletedError{Name: research.Name}
+	}
+
+	g.research.mx.Lock()
+	_, running := g.research.InProgress[research.Name]
+	g.research.mx.Unlock()
+
+	if running {
+		return ResearchInProgressError{Name: research.Name}
+	}
+
+	if err := g.checkPrerequisites(research.Name.String(), research.Requires); err != nil {
+		return err
+	}
+
+	if insufficient := g.insufficientResources(research.Cost, 1); len(insufficient) > 0 {
+		return fmt.Errorf("Insufficient resources: %s", strings.Join(insufficient, ", "))
+	}
+
+	reserved := g.reserveCost(research.Cost)
+
+	timerID, deadline, err := g.startBuildingTimer(ctx, CallbackResearch, research.ResearchTime, map[string]*structpb.Value{
+		KeyResearch: structpb.NewStringValue(research.Name.String()),
+	})
+	if err != nil {
+		g.refundReserved(reserved, 100)
+		return err
+	}
+
+	g.research.mx.Lock()
+	g.research.InProgress[research.Name] = ResearchOrder{
+		TimerID:           timerID,
+		Completion:        deadline,
+		ReservedResources: reserved,
+	}
+	g.research.mx.Unlock()
+
+	slog.Info("started research", "name", research.Name.String(), "completion", deadline)
+
+	return nil
+}
+
+func (g *Grain) researchCallback(t *protobuf.TimerFired) {
+	payload := t.Data.AsMap()
+
+	name, ok := payload[KeyResearch].(string)
+	if !ok {
+		slog.Warn("research callback without research name", "timer_id", t.TimerID)
+		return
+	}
+
+	// Production up to now happened without what the research unlocks
+	g.settleProduction(g.clock.Now())
+
+	if !g.completeResearch(blueprints.ResearchName(name)) {
+		return
+	}
+
+	g.updateLimits()
+	g.restartUnlockedTransformers(blueprints.ResearchName(name))
+}
+
+func (g *Grain) completeResearch(name blueprints.ResearchName) bool {
+	g.research.mx.Lock()
+	defer g.research.mx.Unlock()
+
+	order, ok := g.research.InProgress[name]
+	if !ok {
+		slog.Warn("finished research not found", "name", name.String())
+		return false
+	}
+
+	g.releaseReserved(order.ReservedResources)
+
+	delete(g.research.InProgress, name)
+	g.research.Completed[name] = g.clock.Now()
+
+	slog.Info("finished research", "name", name.String())
+
+	return true
+}
+
+// restartUnlockedTransformers restarts the transformers of every running building
+// with a transformer depending on the given research
+func (g *Grain) restartUnlockedTransformers(name blueprints.ResearchName) {
+	for _, register := range g.buildings {
+		blueprint, err := registry.GetBuilding(register.Name)
+		if err != nil {
+			slog.Warn("failed to retrieve blueprint from registry", "name", register.Name)
+			continue
+		}
+
+		register.mx.Lock()
+		buildings := make([]Building, 0, len(register.Completed))
+
+		for _, building := range register.Completed {
+			if !building.Paused && requiresResearch(blueprint.AtLevel(building.CurrentLevel()), name) {
+				buildings = append(buildings, building)
+			}
+		}
+		register.mx.Unlock()
+
+		for _, building := range buildings {
+			if building.Timers != nil {
+				for _, timerID := range building.Timers.Transformers {
+					g.stopTimer(timerID)
+				}
+			}
+
+			g.startBuildingTransformers(building.ID, blueprint)
+		}
+	}
+}
+
+func requiresResearch(blueprint *blueprints.Building, name blueprints.ResearchName) bool {
+	for _, transformer := range blueprint.Transforms {
+		for _, required := range transformer.Requires {
+			if required == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// capBonuses sums up the cap bonuses granted by finished research
+func (g *Grain) capBonuses() map[blueprints.ResourceName]int {
+	bonuses := make(map[blueprints.ResourceName]int)
+
+	if g.research == nil {
+		return bonuses
+	}
+
+	g.research.mx.Lock()
+	defer g.research.mx.Unlock()
+
+	for name := range g.research.Completed {
+		research, err := registry.GetResearch(name)
+		if err != nil {
+			slog.Warn("failed to retrieve research blueprint", "name", name.String())
+			continue
+		}
+
+		for resource, bonus := range research.CapBonus {
+			bonuses[resource] += bonus
+		}
+	}
+
+	return bonuses
+}
+
+func (g *Grain) describeResearch() *structpb.Value {
+	completed := make([]interface{}, 0)
+	inProgress := make(map[string]interface{})
+
+	if g.research != nil {
+		g.research.mx.Lock()
+		for name := range g.research.Completed {
+			completed = append(completed, name.String())
+		}
+
+		for name, order := range g.research.InProgress {
+			inProgress[name.String()] = order.Completion.Format(time.RFC3339)
+		}
+		g.research.mx.Unlock()
+	}
+
+	value, err := structpb.NewValue(map[string]interface{}{
+		"completed":   completed,
+		"in_progress": inProgress,
+	})
+	if err != nil {
+		slog.Warn("failed to marshal research", "error", err)
+		return structpb.NewNullValue()
+	}
+
+	return value
+}
@@ -0,0 +1,83 @@
+package inventory
+
+import (
+	"sync"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slog"
+)
+
+// TickReservations keeps track of the resources transformer timers reserved for their current tick,
+// so they can be returned if the timer is stopped before it fires
+type TickReservations struct {
+	mx *sync.Mutex
+
+	Timers map[uuid.UUID]map[blueprints.ResourceName]int
+}
+
+func NewTickReservations() *TickReservations {
+	return &TickReservations{
+		mx:     &sync.Mutex{},
+		Timers: make(map[uuid.UUID]map[blueprints.ResourceName]int),
+	}
+}
+
+func (tr *TickReservations) Set(timerID uuid.UUID, reserved map[blueprints.ResourceName]int) {
+	tr.mx.Lock()
+	defer tr.mx.Unlock()
+
+	tr.Timers[timerID] = reserved
+}
+
+// Take removes and returns the reservation of a timer
+func (tr *TickReservations) Take(timerID uuid.UUID) map[blueprints.ResourceName]int {
+	tr.mx.Lock()
+	defer tr.mx.Unlock()
+
+	reserved := tr.Timers[timerID]
+	delete(tr.Timers, timerID)
+
+	return reserved
+}
+
+// releaseTickReservation returns the resources reserved for the current tick of a stopped timer
+func (g *Grain) releaseTickReservation(timerID uuid.UUID) {
+	if g.tickReservations == nil {
+		return
+	}
+
+	for name, amount := range g.tickReservations.Take(timerID) {
+		resource, ok := g.resources[name]
+		if !ok {
+			slog.Warn("reserved resource not found", "resource", name)
+			continue
+		}
+
+		resource.mx.Lock()
+		resource.Reserved -= amount
+		resource.Amount += amount
+		resource.mx.Unlock()
+
+		slog.Debug("released tick reservation", "timer_id", timerID.String(), "resource", name, "amount", amount)
+	}
+}
+
+// releaseTickReservations returns the resources of every tracked tick reservation
+func (g *Grain) releaseTickReservations() {
+	if g.tickReservations == nil {
+		return
+	}
+
+	g.tickReservations.mx.Lock()
+	timerIDs := make([]uuid.UUID, 0, len(g.tickReservations.Timers))
+
+	for timerID := range g.tickReservations.Timers {
+		timerIDs = append(timerIDs, timerID)
+	}
+	g.tickReservations.mx.Unlock()
+
+	for _, timerID := range timerIDs {
+		g.releaseTickReservation(timerID)
+	}
+}
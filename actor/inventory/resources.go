@@ -1,7 +1,7 @@
 package inventory
 
 import (
-	"fmt"
+	"math"
 	"strings"
 	"sync"
 
@@ -20,6 +20,9 @@ type ResourceRegister struct {
 	Cap        int
 	Amount     int
 	Reserved   int
+	Bonus      int
+	// Version is the version of the blueprint the cap formula was taken from
+	Version int
 }
 
 func (rr *ResourceRegister) UpdateCap(resources map[blueprints.ResourceName]*ResourceRegister, buildings map[uuid.UUID]*BuildingRegister) error {
@@ -31,11 +34,7 @@ func (rr *ResourceRegister) UpdateCap(resources map[blueprints.ResourceName]*Res
 	l := lua.NewState()
 	defer l.Close()
 
-	fn := fmt.Sprintf(`
-function derive(buildings, resources)
-	%s
-end
-`, rr.CapFormula)
+	fn := blueprints.CapFunction(rr.CapFormula)
 	slog.Debug("registering lua function", "function", fn)
 
 	resTbl := &lua.LTable{} // nolint
@@ -49,7 +48,7 @@ end
 
 	for _, building := range buildings {
 		slog.Debug("setting building table item", "resource", rr.Name, "name", building.Name, "amount", len(building.Completed))
-		buildTbl.RawSetString(strings.ToLower(string(building.Name)), lua.LNumber(len(building.Completed)))
+		buildTbl.RawSetString(strings.ToLower(string(building.Name)), buildingTable(l, building))
 	}
 
 	if err := l.DoString(fn); err != nil {
@@ -67,13 +66,72 @@ end
 
 	if cap, ok := l.Get(1).(lua.LNumber); ok {
 		slog.Debug("setting new cap", "resource", rr.Name, "cap", int(cap))
-		rr.Cap = int(cap)
+		rr.Cap = int(cap) + rr.Bonus
 		rr.Update(0)
 	}
 
 	return nil
 }
 
+// buildingTable exposes a building register to cap formulas. The count field holds the number of
+// completed buildings, levels holds the number of completed buildings per level. Formulas written
+// before levels existed use the register as a number, so arithmetic on the table works on the count.
+func buildingTable(l *lua.LState, register *BuildingRegister) *lua.LTable {
+	maxLevel := 1
+	if blueprint, err := registry.GetBuilding(register.Name); err == nil {
+		maxLevel = blueprint.MaxLevel()
+	}
+
+	for _, building := range register.Completed {
+		if building.CurrentLevel() > maxLevel {
+			maxLevel = building.CurrentLevel()
+		}
+	}
+
+	levels := make([]int, maxLevel+1)
+	for _, building := range register.Completed {
+		levels[building.CurrentLevel()]++
+	}
+
+	levelTbl := l.NewTable()
+	for level := 1; level <= maxLevel; level++ {
+		levelTbl.RawSetInt(level, lua.LNumber(levels[level]))
+	}
+
+	tbl := l.NewTable()
+	tbl.RawSetString("count", lua.LNumber(len(register.Completed)))
+	tbl.RawSetString("levels", levelTbl)
+
+	meta := l.NewTable()
+	for event, op := range map[string]func(a, b float64) float64{
+		"__add": func(a, b float64) float64 { return a + b },
+		"__sub": func(a, b float64) float64 { return a - b },
+		"__mul": func(a, b float64) float64 { return a * b },
+		"__div": func(a, b float64) float64 { return a / b },
+		"__mod": math.Mod,
+		"__pow": math.Pow,
+		"__unm": func(a, _ float64) float64 { return -a },
+	} {
+		op := op
+		meta.RawSetString(event, l.NewFunction(func(l *lua.LState) int {
+			l.Push(lua.LNumber(op(buildingCount(l.Get(1)), buildingCount(l.Get(2)))))
+			return 1
+		}))
+	}
+
+	l.SetMetatable(tbl, meta)
+
+	return tbl
+}
+
+func buildingCount(v lua.LValue) float64 {
+	if tbl, ok := v.(*lua.LTable); ok {
+		v = tbl.RawGetString("count")
+	}
+
+	return float64(lua.LVAsNumber(v))
+}
+
 func (g *Grain) getStartingResources() (map[blueprints.ResourceName]*ResourceRegister, error) {
 	registers := make(map[blueprints.ResourceName]*ResourceRegister)
 
@@ -89,7 +147,9 @@ func (g *Grain) getStartingResources() (map[blueprints.ResourceName]*ResourceReg
 			Amount:     resource.StartingAmount,
 			Reserved:   0,
 			Cap:        0,
+			Bonus:      0,
 			CapFormula: resource.CapFormula,
+			Version:    resource.Version,
 		}
 	}
 
@@ -133,5 +193,9 @@ func (rr *ResourceRegister) Update(amount int) {
 		"cap", rr.Cap,
 	)
 
-	rr.Amount = newAmount
+	// The cap applies to the reserved amount as well, but only the available part is stored in Amount
+	rr.Amount = newAmount - rr.Reserved
+	if rr.Amount < 0 {
+		rr.Amount = 0
+	}
 }
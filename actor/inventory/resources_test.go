@@ -2,7 +2,9 @@ package inventory
 
 import (
 	"testing"
+	"time"
 
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
 	"github.com/stretchr/testify/assert"
 )
@@ -12,7 +14,7 @@ func TestUpdateCap(t *testing.T) {
 		t.Fatalf("Fail: %v", err)
 	}
 
-	g := &Grain{}
+	g := New(clock.NewFake(time.Now()))
 	var assetError error
 
 	g.buildings, assetError = g.getStartingBuildings()
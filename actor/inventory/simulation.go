@@ -0,0 +1,341 @@
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SimulationIdentity is the identity of the inventory of a simulation
+const SimulationIdentity = "simulation"
+
+// Simulation runs an inventory on a fake clock, without a cluster, to see how the blueprints of the
+// registry play out over time. Requests go through the same methods as the requests of the players,
+// the timers of constructions, upgrades and research fire on the simulation clock, and production
+// is settled lazily, whatever production mode is configured.
+type Simulation struct {
+	grain *Grain
+	clock *clock.Fake
+
+	mx     sync.Mutex
+	timers []*simulatedTimer
+}
+
+// simulatedTimer is a timer of the inventory waiting for the simulation clock to reach its deadline
+type simulatedTimer struct {
+	id       uuid.UUID
+	deadline time.Time
+	request  *protobuf.TimerRequest
+}
+
+// simulationContext stands in for the grain context, the simulated inventory only needs its identity
+type simulationContext struct {
+	cluster.GrainContext
+}
+
+func (simulationContext) Identity() string {
+	return SimulationIdentity
+}
+
+// NewSimulation creates an inventory with the starting buildings and resources of the registry at
+// the given time
+func NewSimulation(start time.Time) *Simulation {
+	s := &Simulation{ // nolint:exhaustruct
+		clock:  clock.NewFake(start),
+		timers: make([]*simulatedTimer, 0),
+	}
+
+	s.grain = New(s.clock)
+	s.grain.productionMode = config.ProductionModeLazy
+	s.grain.timerClient = func(timerID uuid.UUID) timerClient {
+		return simulatedTimerClient{simulation: s, timerID: timerID}
+	}
+
+	s.grain.setup(simulationContext{}) // nolint:exhaustruct
+	s.grain.startProduction()
+
+	return s
+}
+
+// Now returns the time of the simulation clock
+func (s *Simulation) Now() time.Time {
+	return s.clock.Now()
+}
+
+// Next returns the deadline of the next timer, false if no timer is waiting
+func (s *Simulation) Next() (time.Time, bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if len(s.timers) == 0 {
+		return time.Time{}, false
+	}
+
+	return s.timers[0].deadline, true
+}
+
+// AdvanceTo moves the simulation clock to t, firing every timer due by then in deadline order,
+// and settles the production up to t
+func (s *Simulation) AdvanceTo(t time.Time) {
+	for {
+		s.mx.Lock()
+		if len(s.timers) == 0 || s.timers[0].deadline.After(t) {
+			s.mx.Unlock()
+			break
+		}
+
+		timer := s.timers[0]
+		s.timers = s.timers[1:]
+		s.mx.Unlock()
+
+		s.clock.Set(timer.deadline)
+		s.fire(timer)
+	}
+
+	s.clock.Set(t)
+	s.grain.settleProduction(t)
+}
+
+func (s *Simulation) fire(timer *simulatedTimer) {
+	delete(s.grain.timers, timer.id)
+
+	for _, cb := range s.grain.callbacks {
+		if cb.Subject != timer.request.Reply {
+			continue
+		}
+
+		cb.Method(&protobuf.TimerFired{
+			TimerID:   timer.id.String(),
+			Timestamp: timestamppb.New(timer.deadline),
+			Data:      timer.request.Data,
+			Sequence:  0,
+		})
+	}
+}
+
+// StartBuilding requests a building like a player would
+func (s *Simulation) StartBuilding(name string) error {
+	res, err := s.grain.StartBuilding(&protobuf.StartBuildingRequest{ // nolint:exhaustruct
+		Name:      name,
+		Amount:    1,
+		Timestamp: timestamppb.New(s.Now()),
+	}, s.grain.ctx)
+	if err != nil {
+		return err
+	}
+
+	if res.Status == protobuf.Status_Error {
+		return errors.New(res.Error)
+	}
+
+	return nil
+}
+
+// StartResearch requests a research like a player would
+func (s *Simulation) StartResearch(name string) error {
+	res, err := s.grain.StartResearch(&protobuf.StartResearchRequest{ // nolint:exhaustruct
+		Name:      name,
+		Timestamp: timestamppb.New(s.Now()),
+	}, s.grain.ctx)
+	if err != nil {
+		return err
+	}
+
+	if res.Status == protobuf.Status_Error {
+		return errors.New(res.Error)
+	}
+
+	return nil
+}
+
+// Staff assigns the available workers to the completed buildings that can take more, the buildings
+// completed first are staffed first
+func (s *Simulation) Staff() error {
+	buildings := make([]Building, 0)
+
+	for _, register := range s.grain.buildings {
+		register.mx.Lock()
+		for _, building := range register.Completed {
+			if building.WorkersCurrent < building.WorkersMaximum {
+				buildings = append(buildings, building)
+			}
+		}
+		register.mx.Unlock()
+	}
+
+	sort.Slice(buildings, func(i, j int) bool {
+		if buildings[i].Completion.Equal(buildings[j].Completion) {
+			return buildings[i].ID.String() < buildings[j].ID.String()
+		}
+
+		return buildings[i].Completion.Before(buildings[j].Completion)
+	})
+
+	for _, building := range buildings {
+		available := 0
+		if workers, ok := s.grain.resources[WorkerResource]; ok {
+			workers.mx.Lock()
+			available = workers.Amount
+			workers.mx.Unlock()
+		}
+
+		if available == 0 {
+			return nil
+		}
+
+		workers := building.WorkersMaximum
+		if missing := building.WorkersMaximum - building.WorkersCurrent; missing > available {
+			workers = building.WorkersCurrent + available
+		}
+
+		res, err := s.grain.AssignWorkers(&protobuf.AssignWorkersRequest{ // nolint:exhaustruct
+			BuildingID: building.ID.String(),
+			Workers:    int64(workers),
+			Timestamp:  timestamppb.New(s.Now()),
+		}, s.grain.ctx)
+		if err != nil {
+			return err
+		}
+
+		if res.Status == protobuf.Status_Error {
+			return errors.New(res.Error)
+		}
+	}
+
+	return nil
+}
+
+// FreeSlots returns the number of buildings that can be requested without waiting for a construction slot
+func (s *Simulation) FreeSlots() int {
+	return s.grain.constructionSlots() - s.grain.activeConstructions() - len(s.grain.queue)
+}
+
+// Researching reports whether a research is in progress
+func (s *Simulation) Researching() bool {
+	s.grain.research.mx.Lock()
+	defer s.grain.research.mx.Unlock()
+
+	return len(s.grain.research.InProgress) > 0
+}
+
+// SimulationState is the inventory of a simulation at a point in time
+type SimulationState struct {
+	Time time.Time
+	// Resources holds the available amount of every resource, reserved amounts are left out
+	Resources map[string]int
+	Reserved  map[string]int
+	Caps      map[string]int
+	// Buildings holds the number of completed buildings by name, Queued the ones waiting for their
+	// construction to finish
+	Buildings map[string]int
+	Queued    map[string]int
+	// Research holds the time every finished research was completed at
+	Research map[string]time.Time
+}
+
+// State returns the inventory at the current time of the simulation
+func (s *Simulation) State() SimulationState {
+	state := SimulationState{
+		Time:      s.Now(),
+		Resources: make(map[string]int),
+		Reserved:  make(map[string]int),
+		Caps:      make(map[string]int),
+		Buildings: make(map[string]int),
+		Queued:    make(map[string]int),
+		Research:  make(map[string]time.Time),
+	}
+
+	for name, resource := range s.grain.resources {
+		resource.mx.Lock()
+		state.Resources[name.String()] = resource.Amount
+		state.Reserved[name.String()] = resource.Reserved
+		state.Caps[name.String()] = resource.Cap
+		resource.mx.Unlock()
+	}
+
+	for _, register := range s.grain.buildings {
+		register.mx.Lock()
+		state.Buildings[register.Name.String()] = len(register.Completed)
+		state.Queued[register.Name.String()] = len(register.Queue)
+		register.mx.Unlock()
+	}
+
+	s.grain.research.mx.Lock()
+	for name, completed := range s.grain.research.Completed {
+		state.Research[name.String()] = completed
+	}
+	s.grain.research.mx.Unlock()
+
+	return state
+}
+
+// simulatedTimerClient runs the timers of a simulated inventory on the simulation clock
+type simulatedTimerClient struct {
+	simulation *Simulation
+	timerID    uuid.UUID
+}
+
+func (c simulatedTimerClient) CreateTimer(r *protobuf.TimerRequest, opts ...cluster.GrainCallOption) (*protobuf.TimerResponse, error) {
+	// Production is settled lazily, so only the one-off timers of constructions, upgrades and research are left
+	if r.Amount != 0 {
+		return nil, fmt.Errorf("the simulation doesn't run repeating timers")
+	}
+
+	d, err := time.ParseDuration(r.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	s := c.simulation
+	deadline := s.Now().Add(d)
+
+	s.mx.Lock()
+	s.timers = append(s.timers, &simulatedTimer{
+		id:       c.timerID,
+		deadline: deadline,
+		request:  r,
+	})
+	sort.SliceStable(s.timers, func(i, j int) bool {
+		return s.timers[i].deadline.Before(s.timers[j].deadline)
+	})
+	s.mx.Unlock()
+
+	return &protobuf.TimerResponse{
+		TimerID:   c.timerID.String(),
+		Status:    protobuf.Status_OK,
+		Error:     "",
+		Deadline:  timestamppb.New(deadline),
+		Timestamp: timestamppb.New(s.Now()),
+	}, nil
+}
+
+func (c simulatedTimerClient) Cancel(r *protobuf.CancelTimerRequest, opts ...cluster.GrainCallOption) (*protobuf.TimerResponse, error) {
+	s := c.simulation
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	for i, timer := range s.timers {
+		if timer.id == c.timerID {
+			s.timers = append(s.timers[:i], s.timers[i+1:]...)
+			break
+		}
+	}
+
+	return &protobuf.TimerResponse{
+		TimerID:   c.timerID.String(),
+		Status:    protobuf.Status_OK,
+		Error:     "",
+		Deadline:  nil,
+		Timestamp: timestamppb.New(s.Now()),
+	}, nil
+}
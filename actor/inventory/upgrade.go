@@ -0,0 +1,155 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// startUpgrade reserves the cost of the next level of a completed building and starts the upgrade timer.
+// The building keeps working at its current level until the upgrade finishes.
+func (g *Grain) startUpgrade(ctx context.Context, buildingID uuid.UUID) error {
+	register := g.completedRegister(buildingID)
+	if register == nil {
+		return BuildingNotFoundError{BuildingID: buildingID.String()}
+	}
+
+	register.mx.Lock()
+	defer register.mx.Unlock()
+
+	building := register.Completed[buildingID]
+	if building.Upgrade != nil {
+		return UpgradeInProgressError{BuildingID: buildingID.String()}
+	}
+
+	blueprint, err := registry.GetBuilding(register.Name)
+	if err != nil {
+		return err
+	}
+
+	level := building.CurrentLevel() + 1
+	if level > blueprint.MaxLevel() {
+		return MaxLevelError{Name: blueprint.Name, Level: building.CurrentLevel()}
+	}
+
+	leveled := blueprint.AtLevel(level)
+
+	if insufficient := g.insufficientResources(leveled.Cost, 1); len(insufficient) > 0 {
+		return fmt.Errorf("Insufficient resources: %s", strings.Join(insufficient, ", "))
+	}
+
+	reserved := g.reserveCost(leveled.Cost)
+
+	timerID, deadline, err := g.startBuildingTimer(ctx, CallbackUpgrades, leveled.BuildTime, map[string]*structpb.Value{
+		KeyId:       structpb.NewStringValue(buildingID.String()),
+		KeyBuilding: structpb.NewStringValue(string(blueprint.Name)),
+		KeyLevel:    structpb.NewNumberValue(float64(level)),
+	})
+	if err != nil {
+		g.refundReserved(reserved, 100)
+		return err
+	}
+
+	building.Upgrade = &Upgrade{
+		Level:             level,
+		TimerID:           timerID,
+		Completion:        deadline,
+		ReservedResources: reserved,
+	}
+	register.Completed[buildingID] = building
+
+	slog.Info("started building upgrade", "name", string(blueprint.Name), "id", buildingID.String(), "level", level)
+
+	return nil
+}
+
+func (g *Grain) upgradeCallback(t *protobuf.TimerFired) {
+	defer g.updateLimits()
+
+	payload := t.Data.AsMap()
+	buildingIDStr := payload[KeyId].(string)
+
+	buildingID, err := uuid.Parse(buildingIDStr)
+	if err != nil {
+		slog.Warn("failed to parse building ID", "raw", buildingIDStr)
+		return
+	}
+
+	// Production up to now happened at the previous level
+	g.settleProduction(g.clock.Now())
+
+	building, blueprint, ok := g.completeUpgrade(buildingID)
+	if !ok || building.Paused {
+		return
+	}
+
+	// Restart the production of the building with the blueprint of the new level
+	g.stopBuildingTimers(building)
+	g.startBuildingGenerators(buildingID, blueprint)
+	g.startBuildingTransformers(buildingID, blueprint)
+}
+
+func (g *Grain) completeUpgrade(buildingID uuid.UUID) (Building, *blueprints.Building, bool) {
+	register := g.completedRegister(buildingID)
+	if register == nil {
+		slog.Warn("upgraded building not found", "building_id", buildingID.String())
+		return Building{}, nil, false // nolint:exhaustruct
+	}
+
+	blueprint, err := registry.GetBuilding(register.Name)
+	if err != nil {
+		slog.Warn("failed to retrieve blueprint from registry", "name", register.Name)
+		return Building{}, nil, false // nolint:exhaustruct
+	}
+
+	register.mx.Lock()
+	defer register.mx.Unlock()
+
+	building := register.Completed[buildingID]
+	if building.Upgrade == nil {
+		slog.Warn("upgraded building has no upgrade in progress", "building_id", buildingID.String())
+		return Building{}, nil, false // nolint:exhaustruct
+	}
+
+	g.releaseReserved(building.Upgrade.ReservedResources)
+
+	building.Level = building.Upgrade.Level
+	building.Upgrade = nil
+	register.Completed[buildingID] = building
+
+	slog.Info("finished building upgrade", "name", string(register.Name), "id", buildingID.String(), "level", building.Level)
+
+	return building, blueprint, true
+}
+
+// cancelUpgrade stops the upgrade timer of a building and refunds the reserved cost in full
+func (g *Grain) cancelUpgrade(building Building) {
+	if building.Upgrade == nil {
+		return
+	}
+
+	g.stopTimer(building.Upgrade.TimerID)
+	g.refundReserved(building.Upgrade.ReservedResources, 100)
+}
+
+// completedRegister returns the register holding the given completed building
+func (g *Grain) completedRegister(buildingID uuid.UUID) *BuildingRegister {
+	for _, register := range g.buildings {
+		register.mx.Lock()
+		_, ok := register.Completed[buildingID]
+		register.mx.Unlock()
+
+		if ok {
+			return register
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package inventory
+
+import (
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slog"
+)
+
+// WorkerResource is the resource buildings are staffed from
+const WorkerResource = blueprints.Population
+
+// completedState returns the state of a completed building based on its staffing.
+// Paused buildings and buildings without the workers they need are inactive.
+func (b Building) completedState() protobuf.BuildingState {
+	if b.Paused || (b.WorkersMaximum > 0 && b.WorkersCurrent == 0) {
+		return protobuf.BuildingState_BuildingStateInactive
+	}
+
+	return protobuf.BuildingState_BuildingStateActive
+}
+
+// scaleOutput returns the part of the given amount a building produces with its current staff
+func (b Building) scaleOutput(amount int) int {
+	if b.Paused {
+		return 0
+	}
+
+	if b.WorkersMaximum == 0 {
+		return amount
+	}
+
+	return amount * b.WorkersCurrent / b.WorkersMaximum
+}
+
+// findCompleted looks up a completed building by its ID
+func (g *Grain) findCompleted(buildingID uuid.UUID) (Building, bool) {
+	for _, register := range g.buildings {
+		register.mx.Lock()
+		building, ok := register.Completed[buildingID]
+		register.mx.Unlock()
+
+		if ok {
+			return building, true
+		}
+	}
+
+	return Building{}, false // nolint:exhaustruct
+}
+
+// assignWorkers sets the number of workers of a completed building. Workers added to the building
+// are reserved from the available population, removed workers are returned to it.
+func (g *Grain) assignWorkers(buildingID uuid.UUID, workers int) error {
+	register := g.completedRegister(buildingID)
+	if register == nil {
+		return BuildingNotFoundError{BuildingID: buildingID.String()}
+	}
+
+	return g.assignRegisterWorkers(register, buildingID, workers)
+}
+
+func (g *Grain) assignRegisterWorkers(register *BuildingRegister, buildingID uuid.UUID, workers int) error {
+	register.mx.Lock()
+	defer register.mx.Unlock()
+
+	building, ok := register.Completed[buildingID]
+	if !ok {
+		return BuildingNotFoundError{BuildingID: buildingID.String()}
+	}
+
+	if workers < 0 || workers > building.WorkersMaximum {
+		return InvalidWorkerAmountError{Workers: workers, Maximum: building.WorkersMaximum}
+	}
+
+	if err := g.reserveWorkers(workers - building.WorkersCurrent); err != nil {
+		return err
+	}
+
+	building.WorkersCurrent = workers
+	building.State = building.completedState()
+	register.Completed[buildingID] = building
+
+	slog.Info("assigned workers", "name", string(register.Name), "id", buildingID.String(), "workers", workers)
+
+	return nil
+}
+
+// reserveWorkers moves the given amount of workers from the available population to the reserved amount.
+// A negative amount releases workers.
+func (g *Grain) reserveWorkers(amount int) error {
+	if amount == 0 {
+		return nil
+	}
+
+	resource, ok := g.resources[WorkerResource]
+	if !ok {
+		return InvalidResourceError{Resource: WorkerResource}
+	}
+
+	resource.mx.Lock()
+	defer resource.mx.Unlock()
+
+	if resource.Amount < amount {
+		return InsufficientResourceError{Resource: WorkerResource}
+	}
+
+	resource.Amount -= amount
+	resource.Reserved += amount
+
+	return nil
+}
@@ -8,11 +8,15 @@ import (
 	"github.com/0xa1-red/empires-of-avalon/persistence/encoding"
 	"github.com/0xa1-red/empires-of-avalon/protobuf"
 	"github.com/asynkron/protoactor-go/cluster"
+	"golang.org/x/exp/slog"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func (g *Grain) Encode() ([]byte, error) {
-	if g.timer.Amount == 0 {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	if !g.timer.persistent() && !g.timer.Persisted {
 		return nil, nil
 	}
 
@@ -39,8 +43,8 @@ func (g *Grain) Decode(b []byte) error {
 		return err
 	}
 
-	if m["timer"].(*Timer).Amount > 0 {
-		g.timer = m["timer"].(*Timer)
+	if t := m["timer"].(*Timer); t.persistent() {
+		g.timer = t
 	}
 
 	return nil
@@ -62,9 +66,29 @@ func (g *Grain) Restore(req *protobuf.RestoreRequest, ctx cluster.GrainContext)
 		}, nil
 	}
 
-	g.startBuildingTimer(context.TODO())
-	g.startGenerateTimer(context.TODO())
-	g.startTransformTimer(context.TODO())
+	// The latest snapshot belongs to a finished or cancelled timer
+	if g.timer == nil {
+		g.ctx.Poison(g.ctx.Self())
+
+		return &protobuf.RestoreResponse{
+			Status: protobuf.Status_OK,
+			Error:  "",
+		}, nil
+	}
+
+	// The inventory releases the reservations of its timers when it's restored, so the current
+	// tick has to reserve again
+	g.timer.Reserved = false
+
+	if !g.timer.Paused {
+		g.start(context.Background())
+	}
+
+	if err := g.updateAdmin(protobuf.UpdateKind_Register); err != nil {
+		slog.Warn("failed to send register update to admin actor", err)
+	}
+
+	g.startHeartbeat()
 
 	return &protobuf.RestoreResponse{
 		Status: protobuf.Status_OK,
@@ -2,13 +2,17 @@ package timer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/0xa1-red/empires-of-avalon/actor"
 	"github.com/0xa1-red/empires-of-avalon/instrumentation/traces"
 	"github.com/0xa1-red/empires-of-avalon/persistence"
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
 	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/0xa1-red/empires-of-avalon/transport"
 	"github.com/0xa1-red/empires-of-avalon/transport/nats"
 	"github.com/asynkron/protoactor-go/cluster"
 	"go.opentelemetry.io/otel"
@@ -18,21 +22,57 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Forever is the repeat count of a timer that fires until it's cancelled
+const Forever = -1
+
+var (
+	ErrTimerNotRunning = errors.New("timer is not running")
+	ErrTimerPaused     = errors.New("timer is paused")
+	ErrTimerNotPaused  = errors.New("timer is not paused")
+	ErrInvalidAmount   = errors.New("invalid repeat amount")
+)
+
 type Timer struct {
 	TimerID     string
 	Kind        protobuf.TimerKind
 	InventoryID string
 	Reply       string
-	Amount      int64
+	Amount      int64 // fires left, or Forever
 	Start       time.Time
 	Interval    time.Duration
 	Data        map[string]interface{}
+	Paused      bool
+	Remaining   time.Duration
+	Reserved    bool
+	Persisted   bool
+	Sequence    int64 // number of fires published so far
+}
+
+// persistent reports whether the timer has to survive a restart
+func (t *Timer) persistent() bool {
+	return t.Amount != 0 || t.Paused
+}
+
+// deadline returns the time the timer fires next
+func (t *Timer) deadline() time.Time {
+	return t.Start.Add(t.Interval)
 }
 
 type Grain struct {
 	ctx             cluster.GrainContext
+	clock           clock.Clock
 	timer           *Timer
-	heartbeatTicker *time.Ticker
+	heartbeatTicker clock.Ticker
+	stop            chan struct{}
+	running         sync.WaitGroup
+
+	// mx guards the timer against the goroutine driving it
+	mx sync.Mutex
+}
+
+// New creates a timer grain that takes its time from the given clock
+func New(clk clock.Clock) *Grain {
+	return &Grain{clock: clk} // nolint:exhaustruct
 }
 
 func (g *Grain) Init(ctx cluster.GrainContext) {
@@ -40,15 +80,18 @@ func (g *Grain) Init(ctx cluster.GrainContext) {
 }
 
 func (g *Grain) Terminate(ctx cluster.GrainContext) {
-	if g.timer.Amount > 0 {
-		if n, err := persistence.Get().Persist(g); err != nil {
-			slog.Error("failed to persist grain", err, "kind", g.Kind(), "identity", ctx.Identity())
-		} else {
-			slog.Debug("grain successfully persisted", "kind", g.Kind(), "identity", ctx.Identity(), "written", n)
-		}
+	// Stop the running timer goroutines, otherwise a poisoned grain would keep firing
+	g.halt()
+
+	if g.timer == nil {
+		return
 	}
 
-	g.heartbeatTicker.Stop()
+	g.persist()
+
+	if g.heartbeatTicker != nil {
+		g.heartbeatTicker.Stop()
+	}
 
 	if err := g.updateAdmin(protobuf.UpdateKind_Deregister); err != nil {
 		slog.Warn("failed to send deregister update to admin actor", err)
@@ -65,7 +108,7 @@ func (g *Grain) CreateTimer(req *protobuf.TimerRequest, ctx cluster.GrainContext
 	sctx, span := traces.Start(pctx, "actor/timer/create_timer")
 	defer span.End()
 
-	start := time.Now()
+	start := g.clock.Now()
 
 	d, err := time.ParseDuration(req.Duration)
 	if err != nil {
@@ -77,7 +120,22 @@ func (g *Grain) CreateTimer(req *protobuf.TimerRequest, ctx cluster.GrainContext
 		}, nil
 	}
 
-	g.timer = &Timer{
+	if req.Amount < Forever {
+		span.RecordError(ErrInvalidAmount)
+		return &protobuf.TimerResponse{ // nolint
+			Status:    protobuf.Status_Error,
+			Error:     fmt.Sprintf("%s: %d", ErrInvalidAmount, req.Amount),
+			Timestamp: timestamppb.New(start),
+		}, nil
+	}
+
+	// A one-shot timer is requested with 0 and fires once
+	amount := req.Amount
+	if amount == 0 {
+		amount = 1
+	}
+
+	g.timer = &Timer{ // nolint:exhaustruct
 		TimerID:     req.TimerID,
 		Kind:        req.Kind,
 		Reply:       req.Reply,
@@ -85,210 +143,445 @@ func (g *Grain) CreateTimer(req *protobuf.TimerRequest, ctx cluster.GrainContext
 		Interval:    d,
 		InventoryID: req.InventoryID,
 		Data:        req.Data.AsMap(),
-		Amount:      0,
+		Amount:      amount,
+	}
+
+	slog.Info("starting timer", "trace_id", req.TraceID, "interval", d.String(), "amount", amount)
+
+	g.start(sctx)
+
+	if err := g.updateAdmin(protobuf.UpdateKind_Register); err != nil {
+		slog.Warn("failed to send register update to admin actor", err)
+	}
+
+	g.startHeartbeat()
+
+	return g.response(nil), nil
+}
+
+// Cancel stops the timer for good and removes the grain
+func (g *Grain) Cancel(req *protobuf.CancelTimerRequest, ctx cluster.GrainContext) (*protobuf.TimerResponse, error) {
+	carrier := propagation.MapCarrier{}
+	carrier.Set("traceparent", req.TraceID)
+	pctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	_, span := traces.Start(pctx, "actor/timer/cancel")
+	defer span.End()
+
+	if g.timer == nil {
+		span.RecordError(ErrTimerNotRunning)
+		return g.response(ErrTimerNotRunning), nil
+	}
+
+	g.halt()
+
+	// A cancelled timer mustn't be restored, Terminate persists this over any previous snapshot
+	g.timer.Amount = 0
+	g.timer.Paused = false
+
+	g.publishStopped(g.clock.Now())
+	g.ctx.Poison(g.ctx.Self())
+
+	slog.Debug("timer cancelled", "kind", g.timer.Kind.String(), "timer_id", g.timer.TimerID)
+
+	return &protobuf.TimerResponse{
+		TimerID:   g.timer.TimerID,
+		Status:    protobuf.Status_OK,
+		Error:     "",
+		Deadline:  nil,
+		Timestamp: timestamppb.New(g.clock.Now()),
+	}, nil
+}
+
+// Pause stops the timer and remembers how much time was left until it would have fired
+func (g *Grain) Pause(req *protobuf.PauseTimerRequest, ctx cluster.GrainContext) (*protobuf.TimerResponse, error) {
+	carrier := propagation.MapCarrier{}
+	carrier.Set("traceparent", req.TraceID)
+	pctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	_, span := traces.Start(pctx, "actor/timer/pause")
+	defer span.End()
+
+	if g.timer == nil {
+		span.RecordError(ErrTimerNotRunning)
+		return g.response(ErrTimerNotRunning), nil
+	}
+
+	if g.timer.Paused {
+		span.RecordError(ErrTimerPaused)
+		return g.response(ErrTimerPaused), nil
+	}
+
+	g.halt()
+
+	g.timer.Remaining = g.clock.Until(g.timer.deadline())
+	if g.timer.Remaining < 0 {
+		g.timer.Remaining = 0
+	}
+
+	g.timer.Paused = true
+
+	g.persist()
+
+	slog.Debug("timer paused", "kind", g.timer.Kind.String(), "timer_id", g.timer.TimerID, "remaining", g.timer.Remaining.String())
+
+	return g.response(nil), nil
+}
+
+// Resume restarts a paused timer with the time that was left when it was paused
+func (g *Grain) Resume(req *protobuf.ResumeTimerRequest, ctx cluster.GrainContext) (*protobuf.TimerResponse, error) {
+	carrier := propagation.MapCarrier{}
+	carrier.Set("traceparent", req.TraceID)
+	pctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	sctx, span := traces.Start(pctx, "actor/timer/resume")
+	defer span.End()
+
+	if g.timer == nil {
+		span.RecordError(ErrTimerNotRunning)
+		return g.response(ErrTimerNotRunning), nil
+	}
+
+	if !g.timer.Paused {
+		span.RecordError(ErrTimerNotPaused)
+		return g.response(ErrTimerNotPaused), nil
+	}
+
+	g.timer.Start = g.clock.Now().Add(g.timer.Remaining - g.timer.Interval)
+	g.timer.Remaining = 0
+	g.timer.Paused = false
+
+	g.start(sctx)
+	g.persist()
+
+	slog.Debug("timer resumed", "kind", g.timer.Kind.String(), "timer_id", g.timer.TimerID)
+
+	return g.response(nil), nil
+}
+
+// Reschedule changes the interval of the timer, the next tick happens one new interval from now
+func (g *Grain) Reschedule(req *protobuf.RescheduleTimerRequest, ctx cluster.GrainContext) (*protobuf.TimerResponse, error) {
+	carrier := propagation.MapCarrier{}
+	carrier.Set("traceparent", req.TraceID)
+	pctx := otel.GetTextMapPropagator().Extract(context.Background(), carrier)
+
+	sctx, span := traces.Start(pctx, "actor/timer/reschedule")
+	defer span.End()
+
+	if g.timer == nil {
+		span.RecordError(ErrTimerNotRunning)
+		return g.response(ErrTimerNotRunning), nil
+	}
+
+	d, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		span.RecordError(err)
+		return g.response(err), nil
+	}
+
+	// The running goroutine mustn't see the schedule change under it
+	g.halt()
+
+	g.timer.Interval = d
+	g.timer.Start = g.clock.Now()
+
+	if g.timer.Paused {
+		g.timer.Remaining = d
+	} else {
+		g.start(sctx)
+	}
+
+	g.persist()
+
+	slog.Debug("timer rescheduled", "kind", g.timer.Kind.String(), "timer_id", g.timer.TimerID, "interval", d.String())
+
+	return g.response(nil), nil
+}
+
+// response builds the reply to the timer control requests, the deadline is only set while the timer is running
+func (g *Grain) response(err error) *protobuf.TimerResponse {
+	res := &protobuf.TimerResponse{
+		TimerID:   "",
+		Status:    protobuf.Status_OK,
+		Error:     "",
+		Deadline:  nil,
+		Timestamp: timestamppb.New(g.clock.Now()),
+	}
+
+	if g.timer != nil {
+		g.mx.Lock()
+		res.TimerID = g.timer.TimerID
+
+		if !g.timer.Paused {
+			res.Deadline = timestamppb.New(g.timer.deadline())
+		}
+		g.mx.Unlock()
 	}
 
-	slog.Info("starting timer", "trace_id", req.TraceID, "interval", d.String())
+	if err != nil {
+		res.Status = protobuf.Status_Error
+		res.Error = err.Error()
+	}
+
+	return res
+}
+
+// start launches the goroutine driving the timer, stopping the previous one first
+func (g *Grain) start(ctx context.Context) {
+	g.halt()
 
 	timerFn := g.startBuildingTimer
 
-	switch req.Kind {
+	switch g.timer.Kind {
 	case protobuf.TimerKind_Generator:
 		timerFn = g.startGenerateTimer
 	case protobuf.TimerKind_Transformer:
 		timerFn = g.startTransformTimer
 	}
 
-	go timerFn(sctx)
+	stop := make(chan struct{})
+	g.stop = stop
 
-	deadline := start
-	deadline = deadline.Add(d)
+	g.running.Add(1)
 
-	if err := g.updateAdmin(protobuf.UpdateKind_Register); err != nil {
-		slog.Warn("failed to send register update to admin actor", err)
+	go func() {
+		defer g.running.Done()
+		timerFn(ctx, stop)
+	}()
+}
+
+// halt stops the goroutine driving the timer and waits until it returns
+func (g *Grain) halt() {
+	if g.stop == nil {
+		return
 	}
 
-	g.heartbeatTicker = time.NewTicker(30 * time.Second)
+	close(g.stop)
+	g.stop = nil
+
+	g.running.Wait()
+}
+
+func (g *Grain) startHeartbeat() {
+	if g.heartbeatTicker != nil {
+		return
+	}
+
+	g.heartbeatTicker = g.clock.NewTicker(30 * time.Second)
 	go func() {
-		for range g.heartbeatTicker.C {
+		for range g.heartbeatTicker.C() {
 			if err := g.updateAdmin(protobuf.UpdateKind_Heartbeat); err != nil {
 				slog.Warn("failed to send register update to admin actor", err)
 			}
 		}
 	}()
-
-	return &protobuf.TimerResponse{
-		TimerID:   req.TimerID,
-		Status:    protobuf.Status_OK,
-		Deadline:  timestamppb.New(deadline),
-		Timestamp: timestamppb.Now(),
-		Error:     "",
-	}, nil
 }
 
-func (g *Grain) startBuildingTimer(ctx context.Context) {
-	_, span := traces.Start(ctx, "actor/timer/startBuildingTimer")
-	defer span.End()
+// persist saves the timer if it has to survive a restart, or if an earlier snapshot has to be superseded
+func (g *Grain) persist() {
+	g.mx.Lock()
+	if !g.timer.persistent() && !g.timer.Persisted {
+		g.mx.Unlock()
+		return
+	}
 
-	now := time.Now()
+	g.timer.Persisted = true
+	g.mx.Unlock()
 
-	conn, err := nats.GetConnection()
-	if err != nil {
-		slog.Error("failed to get NATS connection", err)
+	if n, err := persistence.Get().Persist(g); err != nil {
+		slog.Error("failed to persist grain", err, "kind", g.Kind(), "identity", g.ctx.Identity())
+	} else {
+		slog.Debug("grain successfully persisted", "kind", g.Kind(), "identity", g.ctx.Identity(), "written", n)
 	}
+}
 
-	d, err := structpb.NewValue(g.timer.Data)
+func (g *Grain) publishStopped(t time.Time) {
+	conn, err := transport.Get()
 	if err != nil {
-		slog.Error("failed to start timer", err)
+		slog.Error("failed to get transport", err)
+		return
 	}
 
-	if g.timer != nil {
-		nextTrigger := g.timer.Start.Add(g.timer.Interval)
-		if nextTrigger.Before(now) {
-			if err := conn.Publish(g.timer.Reply, &protobuf.TimerFired{
-				TimerID:   g.timer.TimerID,
-				Timestamp: timestamppb.New(now),
-				Data:      d.GetStructValue(),
-			}); err != nil {
-				slog.Error("failed to send TimerFired message", err)
-			}
+	if err := conn.Publish("timer-status", &protobuf.TimerStopped{
+		TimerID:   g.timer.TimerID,
+		Timestamp: timestamppb.New(t),
+	}); err != nil {
+		slog.Error("failed to send TimerStopped message", err)
+	}
+}
 
-			g.timer = nil
+// publishFired sends the next fire of the timer. Through JetStream the message is kept until the
+// inventory acks it, and the id lets the server drop a repeated publish of the same fire. The
+// sequence is only counted by fired, so a timer restored before it saved the fire sends it again
+// with the same sequence.
+func (g *Grain) publishFired(t time.Time, data *structpb.Struct) {
+	sequence := g.timer.Sequence + 1
+
+	msg := &protobuf.TimerFired{
+		TimerID:   g.timer.TimerID,
+		Timestamp: timestamppb.New(t),
+		Data:      data,
+		Sequence:  sequence,
+	}
 
-			return
+	if nats.JetStreamEnabled() {
+		if err := nats.PublishDurable(g.timer.Reply, msg, fmt.Sprintf("%s-%d", g.timer.TimerID, sequence)); err != nil {
+			slog.Error("failed to store TimerFired message", err)
 		}
+
+		return
 	}
 
-	t := time.NewTimer(g.timer.Interval)
+	conn, err := transport.Get()
+	if err != nil {
+		slog.Error("failed to get transport", err)
+		return
+	}
 
-	for curTime := range t.C {
-		slog.Debug("timer fired", "kind", g.timer.Kind.String(), "reply", g.timer.Reply, "inventory", g.timer.InventoryID)
+	if err := conn.Publish(g.timer.Reply, msg); err != nil {
+		slog.Error("failed to send TimerFired message", err)
+	}
+}
 
-		if err := conn.Publish(g.timer.Reply, &protobuf.TimerFired{
-			TimerID:   g.timer.TimerID,
-			Timestamp: timestamppb.New(curTime),
-			Data:      d.GetStructValue(),
-		}); err != nil {
-			slog.Error("failed to send TimerFired message", err)
-		}
+// fired moves the timer on to its next tick and counts the published fire against the repeat
+// amount. The timer is saved after every fire, so a restored one carries on with the sequence,
+// and it's stopped once it's used up.
+func (g *Grain) fired(t time.Time) bool {
+	g.mx.Lock()
+	g.timer.Start = g.timer.deadline()
+	g.timer.Reserved = false
+	g.timer.Sequence++
+
+	if g.timer.Amount > 0 {
+		g.timer.Amount--
+	}
+
+	done := g.timer.Amount == 0
+	g.mx.Unlock()
 
-		if g.timer.Amount == 0 {
+	g.persist()
+
+	if !done {
+		return false
+	}
+
+	g.ctx.Poison(g.ctx.Self())
+	g.publishStopped(t)
+
+	return true
+}
+
+func (g *Grain) startBuildingTimer(ctx context.Context, stop <-chan struct{}) {
+	_, span := traces.Start(ctx, "actor/timer/startBuildingTimer")
+	defer span.End()
+
+	d, err := structpb.NewValue(g.timer.Data)
+	if err != nil {
+		slog.Error("failed to start timer", err)
+	}
+
+	for {
+		// A deadline in the past (eg. after a restore) fires right away
+		t := g.clock.NewTimer(g.clock.Until(g.timer.deadline()))
+
+		select {
+		case <-stop:
 			t.Stop()
-			g.ctx.Poison(g.ctx.Self())
+			slog.Debug("timer stopped before firing", "kind", g.timer.Kind.String(), "timer_id", g.timer.TimerID)
 
-			if err := conn.Publish("timer-status", &protobuf.TimerStopped{
-				TimerID:   g.timer.TimerID,
-				Timestamp: timestamppb.New(curTime),
-			}); err != nil {
-				slog.Error("failed to send TimerStopped message", err)
+			return
+		case curTime := <-t.C():
+			slog.Debug("timer fired", "kind", g.timer.Kind.String(), "reply", g.timer.Reply, "inventory", g.timer.InventoryID)
+
+			g.publishFired(curTime, d.GetStructValue())
+
+			if g.fired(curTime) {
+				return
 			}
 		}
 	}
 }
 
-func (g *Grain) startGenerateTimer(ctx context.Context) {
+func (g *Grain) startGenerateTimer(ctx context.Context, stop <-chan struct{}) {
 	_, span := traces.Start(ctx, "actor/timer/create_timer")
 	defer span.End()
 
-	now := time.Now()
-
-	conn, err := nats.GetConnection()
-	if err != nil {
-		slog.Error("failed to get NATS connection", err)
-	}
-
 	d, err := structpb.NewValue(g.timer.Data)
 	if err != nil {
 		slog.Error("failed to start timer", err)
 	}
 
 	for {
-		nextTrigger := g.timer.Start.Add(g.timer.Interval)
-		if nextTrigger.Before(now) {
-			if err := conn.Publish(g.timer.Reply, &protobuf.TimerFired{
-				TimerID:   g.timer.TimerID,
-				Timestamp: timestamppb.New(now),
-				Data:      d.GetStructValue(),
-			}); err != nil {
-				slog.Error("failed to send TimerFired message", err)
-			}
+		// Ticks missed while the timer wasn't running fire right away
+		t := g.clock.NewTimer(g.clock.Until(g.timer.deadline()))
 
-			g.timer.Start = nextTrigger
-		} else {
-			break
-		}
-	}
+		select {
+		case <-stop:
+			t.Stop()
+			slog.Debug("timer stopped", "kind", g.timer.Kind.String(), "timer_id", g.timer.TimerID)
 
-	t := time.NewTicker(g.timer.Interval)
+			return
+		case curTime := <-t.C():
+			slog.Debug("timer fired", "kind", g.timer.Kind.String(), "reply", g.timer.Reply, "inventory", g.timer.InventoryID)
 
-	for curTime := range t.C {
-		slog.Debug("timer fired", "kind", g.timer.Kind.String(), "reply", g.timer.Reply, "inventory", g.timer.InventoryID)
+			g.publishFired(curTime, d.GetStructValue())
 
-		if err := conn.Publish(g.timer.Reply, &protobuf.TimerFired{
-			TimerID:   g.timer.TimerID,
-			Timestamp: timestamppb.New(curTime),
-			Data:      d.GetStructValue(),
-		}); err != nil {
-			slog.Error("failed to send TimerFired message", err)
+			if g.fired(curTime) {
+				return
+			}
 		}
 	}
 }
 
-func (g *Grain) startTransformTimer(ctx context.Context) {
+func (g *Grain) startTransformTimer(ctx context.Context, stop <-chan struct{}) {
 	ctx, span := traces.Start(ctx, "actor/timer/create_timer")
 	defer span.End()
 
-	now := time.Now()
-
-	conn, err := nats.GetConnection()
-	if err != nil {
-		slog.Error("failed to get NATS connection", err)
-	}
-
 	d, err := structpb.NewValue(g.timer.Data)
 	if err != nil {
 		slog.Error("failed to start timer", err)
 	}
 
 	for {
-		nextTrigger := g.timer.Start.Add(g.timer.Interval)
-		if nextTrigger.Before(now) {
-			if err := conn.Publish(g.timer.Reply, &protobuf.TimerFired{
-				TimerID:   g.timer.TimerID,
-				Timestamp: timestamppb.New(now),
-				Data:      d.GetStructValue(),
-			}); err != nil {
-				slog.Error("failed to send TimerFired message", err)
+		// A timer paused mid-tick already holds the reservation for it
+		if !g.timer.Reserved {
+			if err := g.reserveResources(ctx); err != nil {
+				slog.Error("timer skipped because of reserve error", err)
+			} else {
+				g.mx.Lock()
+				g.timer.Reserved = true
+				g.mx.Unlock()
 			}
-
-			g.timer.Start = nextTrigger
-		} else {
-			break
 		}
-	}
 
-	for {
-		send := true
-		if err := g.reserveResources(ctx); err != nil {
-			send = false
+		t := g.clock.NewTimer(g.clock.Until(g.timer.deadline()))
+
+		var curTime time.Time
+
+		select {
+		case <-stop:
+			t.Stop()
+			slog.Debug("timer stopped", "kind", g.timer.Kind.String(), "timer_id", g.timer.TimerID)
+
+			return
+		case curTime = <-t.C():
 		}
 
-		t := time.NewTimer(g.timer.Interval)
+		// Ticks skipped for lack of resources don't count against the repeat amount
+		if !g.timer.Reserved {
+			g.mx.Lock()
+			g.timer.Start = g.timer.deadline()
+			g.mx.Unlock()
 
-		curTime := <-t.C
+			continue
+		}
 
-		if send {
-			slog.Debug("timer fired", "kind", g.timer.Kind.String(), "reply", g.timer.Reply, "inventory", g.timer.InventoryID)
+		slog.Debug("timer fired", "kind", g.timer.Kind.String(), "reply", g.timer.Reply, "inventory", g.timer.InventoryID)
 
-			if err := conn.Publish(g.timer.Reply, &protobuf.TimerFired{
-				TimerID:   g.timer.TimerID,
-				Timestamp: timestamppb.New(curTime),
-				Data:      d.GetStructValue(),
-			}); err != nil {
-				slog.Error("failed to send TimerFired message", err)
-			}
-		} else {
-			slog.Error("timer skipped because of reserve error", err)
+		g.publishFired(curTime, d.GetStructValue())
+
+		if g.fired(curTime) {
+			return
 		}
 	}
 }
@@ -316,7 +609,8 @@ func (g *Grain) reserveResources(ctx context.Context) error {
 	msg := protobuf.ReserveRequest{
 		TraceID:   carrier.Get("traceparent"),
 		Resources: r.GetStructValue(),
-		Timestamp: timestamppb.Now(),
+		Timestamp: timestamppb.New(g.clock.Now()),
+		TimerID:   g.timer.TimerID,
 	}
 
 	res, err := ig.Reserve(&msg)
@@ -370,6 +664,8 @@ func getResourcesFromTimer(ctx context.Context, t *Timer) (map[string]interface{
 func (g *Grain) Describe(req *protobuf.DescribeTimerRequest, ctx cluster.GrainContext) (*protobuf.DescribeTimerResponse, error) {
 	timer := make(map[string]interface{})
 
+	g.mx.Lock()
+
 	timer["timer_id"] = g.timer.TimerID
 	timer["kind"] = g.timer.Kind
 	timer["inventory_id"] = g.timer.InventoryID
@@ -378,12 +674,18 @@ func (g *Grain) Describe(req *protobuf.DescribeTimerRequest, ctx cluster.GrainCo
 	timer["start"] = g.timer.Start.Format(time.RFC1123)
 	timer["interval"] = g.timer.Interval.String()
 	timer["data"] = g.timer.Data
+	timer["paused"] = g.timer.Paused
+
+	if g.timer.Paused {
+		timer["remaining"] = g.timer.Remaining.String()
+	}
+	g.mx.Unlock()
 
 	timeStruct, err := structpb.NewStruct(timer)
 	if err != nil {
 		return &protobuf.DescribeTimerResponse{
 			Timer:     nil,
-			Timestamp: timestamppb.Now(),
+			Timestamp: timestamppb.New(g.clock.Now()),
 			Status:    protobuf.Status_Error,
 			Error:     err.Error(),
 		}, nil
@@ -391,7 +693,7 @@ func (g *Grain) Describe(req *protobuf.DescribeTimerRequest, ctx cluster.GrainCo
 
 	return &protobuf.DescribeTimerResponse{
 		Timer:     timeStruct,
-		Timestamp: timestamppb.Now(),
+		Timestamp: timestamppb.New(g.clock.Now()),
 		Status:    protobuf.Status_OK,
 		Error:     "",
 	}, nil
@@ -419,7 +721,7 @@ func (g *Grain) updateAdmin(kind protobuf.UpdateKind) error {
 	return actor.SendUpdate(&protobuf.GrainUpdate{
 		UpdateKind: kind,
 		GrainKind:  protobuf.GrainKind_TimerGrain,
-		Timestamp:  timestamppb.Now(),
+		Timestamp:  timestamppb.New(g.clock.Now()),
 		Identity:   g.ctx.Self().String(),
 		Context:    contextpb,
 	})
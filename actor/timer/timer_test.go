@@ -1,5 +1,527 @@
 package timer
 
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/persistence"
+	"github.com/0xa1-red/empires-of-avalon/persistence/contract"
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/0xa1-red/empires-of-avalon/transport"
+	"github.com/0xa1-red/empires-of-avalon/transport/memory"
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// testContext stands in for the grain context, calling anything it doesn't implement panics
+type testContext struct {
+	cluster.GrainContext
+
+	identity string
+	poisoned atomic.Bool
+}
+
+func (c *testContext) Identity() string {
+	return c.identity
+}
+
+func (c *testContext) Self() *actor.PID {
+	return actor.NewPID("test", c.identity)
+}
+
+func (c *testContext) Poison(*actor.PID) {
+	c.poisoned.Store(true)
+}
+
+func setupTransport(t *testing.T) *memory.Transport {
+	m := memory.New()
+
+	transport.Set(transport.Memory(m))
+	t.Cleanup(func() { transport.Set(nil) })
+
+	return m
+}
+
+// testPersister keeps the latest snapshot it was given
+type testPersister struct {
+	mx       sync.Mutex
+	snapshot []byte
+}
+
+func (p *testPersister) Persist(item contract.Persistable) (int, error) {
+	raw, err := item.Encode()
+	if err != nil {
+		return 0, err
+	}
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.snapshot = raw
+
+	return len(raw), nil
+}
+
+func (p *testPersister) Restore(kind, identity string) error {
+	return nil
+}
+
+func (p *testPersister) latest() []byte {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	return p.snapshot
+}
+
+func setupPersistence(t *testing.T) *testPersister {
+	p := &testPersister{} // nolint:exhaustruct
+
+	persistence.Set(p)
+	t.Cleanup(func() { persistence.Set(nil) })
+
+	return p
+}
+
+func subscribe[T any](t *testing.T, m *memory.Transport, subject string) chan *T {
+	c := make(chan *T, 10)
+
+	sub, err := m.Subscribe(subject, func(msg *T) { c <- msg })
+	if err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	t.Cleanup(func() { sub.Unsubscribe() }) // nolint:errcheck
+
+	return c
+}
+
+func TestGenerateTimerCatchUp(t *testing.T) {
+	m := setupTransport(t)
+	setupPersistence(t)
+	fires := subscribe[protobuf.TimerFired](t, m, "test-resource-callbacks")
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+
+	g := New(clk)
+	g.Init(&testContext{identity: "generator"}) // nolint:exhaustruct
+
+	// A restored timer which missed the ticks at -45s, -25s and -5s
+	g.timer = &Timer{ // nolint:exhaustruct
+		TimerID:  "generator",
+		Kind:     protobuf.TimerKind_Generator,
+		Reply:    "test-resource-callbacks",
+		Amount:   Forever,
+		Start:    clk.Now().Add(-65 * time.Second),
+		Interval: 20 * time.Second,
+		Data:     map[string]interface{}{"resource": "Wood", "amount": 3},
+	}
+
+	g.start(context.Background())
+	defer g.halt()
+
+	for i := int64(1); i <= 3; i++ {
+		fired := <-fires
+		assert.Equal(t, i, fired.Sequence)
+		assert.Equal(t, clk.Now(), fired.Timestamp.AsTime())
+	}
+
+	// The next tick keeps the original schedule
+	clk.BlockUntil(1)
+	clk.Advance(15 * time.Second)
+
+	fired := <-fires
+	assert.Equal(t, int64(4), fired.Sequence)
+	assert.Equal(t, clk.Now(), fired.Timestamp.AsTime())
+	assert.Equal(t, "Wood", fired.Data.AsMap()["resource"])
+}
+
+func TestRepeatingTimer(t *testing.T) {
+	m := setupTransport(t)
+	setupPersistence(t)
+	fires := subscribe[protobuf.TimerFired](t, m, "test-building-callbacks")
+	stopped := subscribe[protobuf.TimerStopped](t, m, "timer-status")
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+	ctx := &testContext{identity: "building"} // nolint:exhaustruct
+
+	g := New(clk)
+	g.Init(ctx)
+
+	data, err := structpb.NewStruct(map[string]interface{}{"building": "House"})
+	assert.NoError(t, err)
+
+	res, err := g.CreateTimer(&protobuf.TimerRequest{ // nolint:exhaustruct
+		TimerID:  "building",
+		Kind:     protobuf.TimerKind_Building,
+		Reply:    "test-building-callbacks",
+		Duration: "10s",
+		Data:     data,
+		Amount:   2,
+	}, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+	assert.Equal(t, clk.Now().Add(10*time.Second), res.Deadline.AsTime())
+
+	for i := int64(1); i <= 2; i++ {
+		// The timer and the heartbeat ticker
+		clk.BlockUntil(2)
+		clk.Advance(10 * time.Second)
+
+		fired := <-fires
+		assert.Equal(t, i, fired.Sequence)
+	}
+
+	assert.Equal(t, "building", (<-stopped).TimerID)
+	assert.True(t, ctx.poisoned.Load())
+	assert.Equal(t, int64(0), g.timer.Amount)
+
+	g.Terminate(ctx)
+	m.Flush()
+
+	select {
+	case <-fires:
+		t.Fatal("timer fired after it was used up")
+	default:
+	}
+}
+
+func TestCreateTimerInvalidAmount(t *testing.T) {
+	setupTransport(t)
+
+	ctx := &testContext{identity: "invalid"} // nolint:exhaustruct
+
+	g := New(clock.NewFake(time.Now()))
+	g.Init(ctx)
+
+	res, err := g.CreateTimer(&protobuf.TimerRequest{ // nolint:exhaustruct
+		TimerID:  "invalid",
+		Kind:     protobuf.TimerKind_Building,
+		Duration: "10s",
+		Amount:   -2,
+	}, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_Error, res.Status)
+}
+
+// startTimer creates a timer firing once 10 seconds from now
+func startTimer(t *testing.T, g *Grain, ctx *testContext, reply string) {
+	data, err := structpb.NewStruct(map[string]interface{}{"building": "House"})
+	assert.NoError(t, err)
+
+	res, err := g.CreateTimer(&protobuf.TimerRequest{ // nolint:exhaustruct
+		TimerID:  ctx.identity,
+		Kind:     protobuf.TimerKind_Building,
+		Reply:    reply,
+		Duration: "10s",
+		Data:     data,
+	}, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+
+	t.Cleanup(func() { g.Terminate(ctx) })
+}
+
+func TestPauseResume(t *testing.T) {
+	m := setupTransport(t)
+	setupPersistence(t)
+	fires := subscribe[protobuf.TimerFired](t, m, "test-pause-callbacks")
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+	ctx := &testContext{identity: "pause"} // nolint:exhaustruct
+
+	g := New(clk)
+	g.Init(ctx)
+	startTimer(t, g, ctx, "test-pause-callbacks")
+
+	// The timer and the heartbeat ticker
+	clk.BlockUntil(2)
+	clk.Advance(4 * time.Second)
+
+	res, err := g.Pause(&protobuf.PauseTimerRequest{}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+	assert.Nil(t, res.Deadline)
+	assert.Equal(t, 6*time.Second, g.timer.Remaining)
+	assert.Nil(t, g.stop)
+
+	res, err = g.Pause(&protobuf.PauseTimerRequest{}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, ErrTimerPaused.Error(), res.Error)
+
+	// The time spent paused doesn't count
+	clk.Advance(time.Hour)
+	m.Flush()
+	assert.Empty(t, fires)
+
+	res, err = g.Resume(&protobuf.ResumeTimerRequest{}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+	assert.Equal(t, clk.Now().Add(6*time.Second), res.Deadline.AsTime())
+	assert.Equal(t, clk.Now(), res.Timestamp.AsTime())
+
+	res, err = g.Resume(&protobuf.ResumeTimerRequest{}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, ErrTimerNotPaused.Error(), res.Error)
+
+	clk.BlockUntil(2)
+	clk.Advance(6 * time.Second)
+
+	fired := <-fires
+	assert.Equal(t, clk.Now(), fired.Timestamp.AsTime())
+}
+
+func TestCancel(t *testing.T) {
+	m := setupTransport(t)
+	setupPersistence(t)
+	fires := subscribe[protobuf.TimerFired](t, m, "test-cancel-callbacks")
+	stopped := subscribe[protobuf.TimerStopped](t, m, "timer-status")
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+	ctx := &testContext{identity: "cancel"} // nolint:exhaustruct
+
+	g := New(clk)
+	g.Init(ctx)
+	startTimer(t, g, ctx, "test-cancel-callbacks")
+
+	clk.BlockUntil(2)
+
+	res, err := g.Cancel(&protobuf.CancelTimerRequest{}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+	assert.Nil(t, res.Deadline)
+
+	stop := <-stopped
+	assert.Equal(t, "cancel", stop.TimerID)
+	assert.Equal(t, clk.Now(), stop.Timestamp.AsTime())
+	assert.True(t, ctx.poisoned.Load())
+	assert.Nil(t, g.stop)
+	assert.False(t, g.timer.persistent())
+
+	// The goroutine is gone, the deadline passes without a fire
+	clk.Advance(time.Minute)
+	m.Flush()
+	assert.Empty(t, fires)
+}
+
+func TestRescheduleRunning(t *testing.T) {
+	m := setupTransport(t)
+	setupPersistence(t)
+	fires := subscribe[protobuf.TimerFired](t, m, "test-reschedule-callbacks")
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+	ctx := &testContext{identity: "reschedule"} // nolint:exhaustruct
+
+	g := New(clk)
+	g.Init(ctx)
+	startTimer(t, g, ctx, "test-reschedule-callbacks")
+
+	clk.BlockUntil(2)
+	clk.Advance(4 * time.Second)
+
+	res, err := g.Reschedule(&protobuf.RescheduleTimerRequest{Duration: "30s"}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+	assert.Equal(t, clk.Now().Add(30*time.Second), res.Deadline.AsTime())
+
+	// The old deadline passes without a fire
+	clk.BlockUntil(2)
+	clk.Advance(6 * time.Second)
+	m.Flush()
+	assert.Empty(t, fires)
+
+	clk.Advance(24 * time.Second)
+
+	fired := <-fires
+	assert.Equal(t, clk.Now(), fired.Timestamp.AsTime())
+}
+
+func TestReschedulePaused(t *testing.T) {
+	setupTransport(t)
+	setupPersistence(t)
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+	ctx := &testContext{identity: "reschedule-paused"} // nolint:exhaustruct
+
+	g := New(clk)
+	g.Init(ctx)
+	startTimer(t, g, ctx, "test-reschedule-paused-callbacks")
+
+	clk.BlockUntil(2)
+	clk.Advance(4 * time.Second)
+
+	_, err := g.Pause(&protobuf.PauseTimerRequest{}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+
+	// A paused timer stays paused, with the whole new interval left
+	res, err := g.Reschedule(&protobuf.RescheduleTimerRequest{Duration: "20s"}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+	assert.Nil(t, res.Deadline)
+	assert.True(t, g.timer.Paused)
+	assert.Equal(t, 20*time.Second, g.timer.Remaining)
+	assert.Nil(t, g.stop)
+
+	clk.Advance(time.Minute)
+
+	res, err = g.Resume(&protobuf.ResumeTimerRequest{}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, clk.Now().Add(20*time.Second), res.Deadline.AsTime())
+}
+
+// restore hands the snapshot of the timer to a fresh grain
+func restore(t *testing.T, clk clock.Clock, timer *Timer) (*Grain, *testContext) {
+	snapshot := New(clk)
+	snapshot.Init(&testContext{identity: timer.TimerID}) // nolint:exhaustruct
+	snapshot.timer = timer
+
+	b, err := snapshot.Encode()
+	if err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+
+	ctx := &testContext{identity: timer.TimerID} // nolint:exhaustruct
+
+	g := New(clk)
+	g.Init(ctx)
+
+	res, err := g.Restore(&protobuf.RestoreRequest{Data: b}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+
+	t.Cleanup(func() {
+		g.halt()
+
+		if g.heartbeatTicker != nil {
+			g.heartbeatTicker.Stop()
+		}
+	})
+
+	return g, ctx
+}
+
+func TestRestoreRepeatingTimer(t *testing.T) {
+	m := setupTransport(t)
+	setupPersistence(t)
+	fires := subscribe[protobuf.TimerFired](t, m, "test-restore-callbacks")
+	stopped := subscribe[protobuf.TimerStopped](t, m, "timer-status")
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+
+	// Two fires left, the first one was due 5 seconds ago
+	g, ctx := restore(t, clk, &Timer{ // nolint:exhaustruct
+		TimerID:  "restored",
+		Kind:     protobuf.TimerKind_Building,
+		Reply:    "test-restore-callbacks",
+		Amount:   2,
+		Start:    clk.Now().Add(-15 * time.Second),
+		Interval: 10 * time.Second,
+		Data:     map[string]interface{}{"building": "House"},
+	})
+
+	fired := <-fires
+	assert.Equal(t, clk.Now(), fired.Timestamp.AsTime())
+
+	// The timer and the heartbeat ticker
+	clk.BlockUntil(2)
+	clk.Advance(5 * time.Second)
+
+	fired = <-fires
+	assert.Equal(t, clk.Now(), fired.Timestamp.AsTime())
+
+	assert.Equal(t, "restored", (<-stopped).TimerID)
+	assert.True(t, ctx.poisoned.Load())
+
+	g.halt()
+	assert.Equal(t, int64(0), g.timer.Amount)
+}
+
+func TestRestorePausedTimer(t *testing.T) {
+	setupTransport(t)
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+
+	g, ctx := restore(t, clk, &Timer{ // nolint:exhaustruct
+		TimerID:   "paused",
+		Kind:      protobuf.TimerKind_Building,
+		Reply:     "test-paused-callbacks",
+		Amount:    1,
+		Start:     clk.Now().Add(-time.Hour),
+		Interval:  10 * time.Second,
+		Data:      map[string]interface{}{"building": "House"},
+		Paused:    true,
+		Remaining: 5 * time.Second,
+	})
+
+	// Only the heartbeat ticker waits on the clock, the paused timer isn't started
+	clk.BlockUntil(1)
+	assert.Nil(t, g.stop)
+	assert.False(t, ctx.poisoned.Load())
+	assert.True(t, g.timer.Paused)
+	assert.Equal(t, 5*time.Second, g.timer.Remaining)
+	assert.Equal(t, int64(1), g.timer.Amount)
+}
+
+func TestSequenceSurvivesRestore(t *testing.T) {
+	m := setupTransport(t)
+	p := setupPersistence(t)
+	fires := subscribe[protobuf.TimerFired](t, m, "test-sequence-callbacks")
+
+	clk := clock.NewFake(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC))
+
+	g := New(clk)
+	g.Init(&testContext{identity: "sequence"}) // nolint:exhaustruct
+
+	g.timer = &Timer{ // nolint:exhaustruct
+		TimerID:  "sequence",
+		Kind:     protobuf.TimerKind_Generator,
+		Reply:    "test-sequence-callbacks",
+		Amount:   Forever,
+		Start:    clk.Now(),
+		Interval: 10 * time.Second,
+		Data:     map[string]interface{}{"resource": "Wood"},
+	}
+
+	g.start(context.Background())
+
+	for i := int64(1); i <= 2; i++ {
+		clk.BlockUntil(1)
+		clk.Advance(10 * time.Second)
+
+		assert.Equal(t, i, (<-fires).Sequence)
+	}
+
+	// The grain goes away without being terminated, only the snapshots of the fires are left
+	g.halt()
+
+	ctx := &testContext{identity: "sequence"} // nolint:exhaustruct
+
+	restored := New(clk)
+	restored.Init(ctx)
+
+	t.Cleanup(func() {
+		restored.halt()
+		restored.heartbeatTicker.Stop()
+	})
+
+	res, err := restored.Restore(&protobuf.RestoreRequest{Data: p.latest()}, ctx) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+
+	// The timer and the heartbeat ticker
+	clk.BlockUntil(2)
+	clk.Advance(10 * time.Second)
+
+	fired := <-fires
+	assert.Equal(t, int64(3), fired.Sequence)
+	assert.Equal(t, clk.Now(), fired.Timestamp.AsTime())
+}
+
 // func TestReserveResources(t *testing.T) {
 // 	transformer := blueprints.Transformer{
 // 		Name: "test",
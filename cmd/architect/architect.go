@@ -3,15 +3,21 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"sort"
+	"time"
 
 	"github.com/0xa1-red/empires-of-avalon/config"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/game"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry/remote"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/simulation"
 	"github.com/alecthomas/kong"
 	"github.com/spf13/viper"
+	"golang.org/x/exp/slog"
 	"gopkg.in/yaml.v3"
 )
 
@@ -26,29 +32,59 @@ type LoadCmd struct {
 func (l *LoadCmd) Run(ctx *Context) error {
 	fmt.Println("loading items from " + l.Path)
 
-	buildings, err := registry.ReadYaml[*blueprints.Building](l.Path)
+	docs, err := registry.ReadDocuments(l.Path)
 	if err != nil {
 		return err
 	}
 
-	for _, building := range buildings {
-		building.ID = game.GetBuildingID(building.Name.String())
-		if err := remote.Push(building); err != nil {
+	// Nothing is pushed unless the whole directory is valid
+	if err := blueprints.Validate(docs); err != nil {
+		return err
+	}
+
+	for _, doc := range docs {
+		switch bp := doc.Blueprint.(type) {
+		case *blueprints.Building:
+			bp.ID = game.GetBuildingID(bp.Name.String())
+		case *blueprints.Research:
+			bp.ID = game.GetResearchID(bp.Name.String())
+		}
+
+		if err := remote.Push(doc.Blueprint); err != nil {
 			return err
 		}
+
+		fmt.Printf("%s %s: version %d\n", doc.Blueprint.Kind(), doc.Blueprint.GetName(), doc.Blueprint.GetVersion())
 	}
 
-	resources, err := registry.ReadYaml[*blueprints.Resource](l.Path)
+	return nil
+}
+
+type ValidateCmd struct {
+	Path string `arg:"" name:"path" help:"Path to the blueprint files" type:"path"`
+}
+
+func (v *ValidateCmd) Run(ctx *Context) error {
+	docs, err := registry.ReadDocuments(v.Path)
 	if err != nil {
 		return err
 	}
 
-	for _, resource := range resources {
-		if err := remote.Push(resource); err != nil {
-			return err
+	err = blueprints.Validate(docs)
+
+	var validationError *blueprints.ValidationError
+	if errors.As(err, &validationError) {
+		for _, problem := range validationError.Problems {
+			fmt.Println(problem.String())
 		}
+
+		return fmt.Errorf("found %d problem(s) in %d blueprints", len(validationError.Problems), len(docs))
+	} else if err != nil {
+		return err
 	}
 
+	fmt.Printf("%d blueprints are valid\n", len(docs))
+
 	return nil
 }
 
@@ -93,12 +129,223 @@ func (l *ListCmd) Run(ctx *Context) error {
 	return nil
 }
 
+type DiffCmd struct {
+	Path string `arg:"" name:"path" help:"Path to the blueprint files" type:"path"`
+}
+
+func (d *DiffCmd) Run(ctx *Context) error {
+	docs, err := registry.ReadDocuments(d.Path)
+	if err != nil {
+		return err
+	}
+
+	list, err := remote.List()
+	if err != nil {
+		return err
+	}
+
+	local := make(map[string]map[string]bool)
+	differences := 0
+
+	for _, doc := range docs {
+		kind, name := doc.Blueprint.Kind(), doc.Blueprint.GetName()
+
+		if _, ok := local[kind]; !ok {
+			local[kind] = make(map[string]bool)
+		}
+
+		local[kind][name] = true
+
+		stored, ok := list[kind][name]
+		if !ok {
+			fmt.Printf("+ %s %s\n", kind, name)
+
+			differences++
+
+			continue
+		}
+
+		changes, err := blueprints.Diff(stored, doc.Blueprint)
+		if err != nil {
+			return err
+		}
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		fmt.Printf("~ %s %s (version %d)\n", kind, name, stored.GetVersion())
+
+		for _, change := range changes {
+			fmt.Printf("    %s\n", change.String())
+		}
+
+		differences++
+	}
+
+	// load doesn't delete anything, these are only listed for completeness
+	for _, kind := range []string{blueprints.KindBuilding, blueprints.KindResource, blueprints.KindResearch} {
+		names := make([]string, 0, len(list[kind]))
+		for name := range list[kind] {
+			if !local[kind][name] {
+				names = append(names, name)
+			}
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			fmt.Printf("- %s %s (only in the registry)\n", kind, name)
+
+			differences++
+		}
+	}
+
+	if differences == 0 {
+		fmt.Println("no differences")
+	}
+
+	return nil
+}
+
+type ExportCmd struct {
+	Path string `arg:"" name:"dir" help:"Directory to write the blueprint files to" type:"path"`
+}
+
+func (e *ExportCmd) Run(ctx *Context) error {
+	list, err := remote.List()
+	if err != nil {
+		return err
+	}
+
+	if err := registry.WriteDocuments(e.Path, list); err != nil {
+		return err
+	}
+
+	fmt.Printf("exported %d buildings, %d resources and %d research to %s\n",
+		len(list[blueprints.KindBuilding]),
+		len(list[blueprints.KindResource]),
+		len(list[blueprints.KindResearch]),
+		e.Path,
+	)
+
+	return nil
+}
+
+type DeleteCmd struct {
+	Kind string `arg:"" name:"kind" help:"Kind of the blueprint" enum:"building,resource,research"`
+	Name string `arg:"" name:"name" help:"Name of the blueprint"`
+}
+
+func (d *DeleteCmd) Run(ctx *Context) error {
+	if err := remote.Delete(d.Kind, d.Name); err != nil {
+		return err
+	}
+
+	fmt.Printf("deleted %s %s\n", d.Kind, d.Name)
+
+	return nil
+}
+
+type RollbackCmd struct {
+	Kind    string `arg:"" name:"kind" help:"Kind of the blueprint" enum:"building,resource,research"`
+	Name    string `arg:"" name:"name" help:"Name of the blueprint"`
+	Version int    `name:"version" help:"Version to roll back to" required:""`
+}
+
+func (r *RollbackCmd) Run(ctx *Context) error {
+	bp, err := remote.Rollback(r.Kind, r.Name, r.Version)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s %s: rolled back to version %d as version %d\n", r.Kind, r.Name, r.Version, bp.GetVersion())
+
+	return nil
+}
+
+type SimulateCmd struct {
+	Path     string        `arg:"" name:"path" help:"Path to the blueprint files" type:"path"`
+	Duration time.Duration `name:"duration" help:"Simulated time" default:"24h"`
+	Interval time.Duration `name:"interval" help:"Time between samples" default:"1m"`
+	Script   string        `name:"script" help:"Build order to follow instead of the greedy one" type:"existingfile"`
+	Format   string        `name:"format" help:"Output format" enum:"csv,json" default:"csv"`
+	Output   string        `name:"output" short:"o" help:"File to write the samples to instead of stdout" type:"path"`
+}
+
+func (s *SimulateCmd) Run(ctx *Context) error {
+	if !ctx.Debug {
+		slog.SetDefault(slog.New(slog.HandlerOptions{Level: slog.LevelWarn}.NewTextHandler(os.Stderr))) // nolint:exhaustruct
+	}
+
+	// The simulation never touches the shared registry
+	viper.Set(config.Registry_Remote_Kind, config.RegistryMemory)
+
+	if err := registry.Load(s.Path); err != nil {
+		return err
+	}
+
+	opts := simulation.Options{
+		Duration: s.Duration,
+		Interval: s.Interval,
+		Script:   nil,
+	}
+
+	if s.Script != "" {
+		script, err := simulation.ReadScript(s.Script)
+		if err != nil {
+			return err
+		}
+
+		opts.Script = script
+	}
+
+	result, err := simulation.Run(time.Now(), opts)
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+
+	if s.Output != "" {
+		fp, err := os.Create(s.Output)
+		if err != nil {
+			return err
+		}
+		defer fp.Close() // nolint
+
+		out = fp
+	}
+
+	switch s.Format {
+	case "json":
+		return simulation.WriteJSON(out, result)
+	default:
+		if err := simulation.WriteCSV(out, result); err != nil {
+			return err
+		}
+	}
+
+	// The milestones are part of the JSON output, next to the table they go to stderr
+	for _, milestone := range result.Milestones {
+		fmt.Fprintln(os.Stderr, milestone.String())
+	}
+
+	return nil
+}
+
 var CLI struct {
 	Debug      bool   `help:"Enable debug mode."`
 	ConfigPath string `name:"config-file" help:"Path to the config file" type:"path" default:"/etc/avalond/config.yaml"`
 
-	Load LoadCmd `cmd:"" help:"Load blueprint files into storage"`
-	List ListCmd `cmd:"" help:"List blueprints"`
+	Load     LoadCmd     `cmd:"" help:"Load blueprint files into storage"`
+	Validate ValidateCmd `cmd:"" help:"Check blueprint files without loading them"`
+	List     ListCmd     `cmd:"" help:"List blueprints"`
+	Diff     DiffCmd     `cmd:"" help:"Show the differences between blueprint files and storage"`
+	Export   ExportCmd   `cmd:"" help:"Write the blueprints in storage to blueprint files"`
+	Delete   DeleteCmd   `cmd:"" help:"Delete a blueprint from storage"`
+	Rollback RollbackCmd `cmd:"" help:"Push an earlier version of a blueprint again"`
+	Simulate SimulateCmd `cmd:"" help:"Simulate an inventory to see how blueprint files play out over time"`
 }
 
 func main() {
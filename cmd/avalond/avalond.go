@@ -18,17 +18,22 @@ import (
 	"github.com/0xa1-red/empires-of-avalon/instrumentation/traces"
 	"github.com/0xa1-red/empires-of-avalon/logging"
 	"github.com/0xa1-red/empires-of-avalon/persistence"
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
 	gamecluster "github.com/0xa1-red/empires-of-avalon/pkg/cluster"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/auth"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/game"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	remoteregistry "github.com/0xa1-red/empires-of-avalon/pkg/service/registry/remote"
 	"github.com/0xa1-red/empires-of-avalon/protobuf"
-	"github.com/0xa1-red/empires-of-avalon/transport/nats"
+	"github.com/0xa1-red/empires-of-avalon/transport"
 	"github.com/0xa1-red/empires-of-avalon/version"
 	"github.com/asynkron/protoactor-go/actor"
 	"github.com/asynkron/protoactor-go/cluster"
 	"github.com/asynkron/protoactor-go/cluster/clusterproviders/etcd"
+	"github.com/asynkron/protoactor-go/cluster/clusterproviders/test"
 	"github.com/asynkron/protoactor-go/cluster/identitylookup/disthash"
 	"github.com/asynkron/protoactor-go/remote"
+	"github.com/google/uuid"
 	"github.com/spf13/viper"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.opentelemetry.io/otel"
@@ -39,11 +44,13 @@ import (
 var (
 	configPath   string
 	printVersion bool
+	devMode      bool
 )
 
 func main() {
 	flag.BoolVar(&printVersion, "version", false, "print version information")
 	flag.StringVar(&configPath, "config-file", "", "path to config file")
+	flag.BoolVar(&devMode, "dev", false, "run a single node cluster without etcd, NATS, Postgres or Auth0")
 	flag.Parse()
 
 	if printVersion {
@@ -53,6 +60,10 @@ func main() {
 
 	config.Setup(configPath)
 
+	if devMode || viper.GetString(config.Cluster_Mode) == config.ClusterModeLocal {
+		config.SetupLocal()
+	}
+
 	if err := logging.Setup(); err != nil {
 		slog.Error("error configuring logging facility", err)
 		os.Exit(1)
@@ -61,9 +72,16 @@ func main() {
 
 	setupInstrumentation()
 
-	initToken()
+	if viper.GetString(config.Authenticator_Kind) == config.AuthenticatorAuth0 {
+		initToken()
+	}
 
-	initDatabase()
+	// Only the static authenticator works without the accounts and players in Postgres
+	if viper.GetString(config.Persistence_Kind) == config.PersistencePostgres ||
+		viper.GetString(config.Authenticator_Kind) != config.AuthenticatorStatic ||
+		viper.GetString(config.Registry_Remote_Kind) == remoteregistry.KindPostgres {
+		initDatabase()
+	}
 
 	initTransport()
 
@@ -75,22 +93,9 @@ func main() {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, os.Interrupt)
 
-	etcdConf := clientv3.Config{ // nolint
-		Endpoints:   viper.GetStringSlice(config.ETCD_Endpoints),
-		DialTimeout: 5 * time.Second,
-		DialOptions: []grpc.DialOption{grpc.WithBlock()},
-		Username:    viper.GetString(config.ETCD_User),
-		Password:    viper.GetString(config.ETCD_Passwd),
-	}
-
-	slog.Debug("creating etcd provider",
-		"endpoints", viper.GetStringSlice(config.ETCD_Endpoints),
-		"username", viper.GetString(config.ETCD_User),
-	)
-
-	provider, err := etcd.NewWithConfig(viper.GetString(config.ETCD_Root), etcdConf)
+	provider, err := clusterProvider()
 	if err != nil {
-		slog.Error("failed to create etcd provider", err)
+		slog.Error("failed to create cluster provider", err)
 		exit(1)
 	}
 
@@ -101,14 +106,15 @@ func main() {
 	slog.Debug("configuring remote", slog.String("host", viper.GetString(config.Node_Host)), slog.String("port", viper.GetString(config.Node_Port)))
 
 	remoteConfig := remote.Configure(viper.GetString(config.Node_Host), viper.GetInt(config.Node_Port))
+	clk := clock.Real()
 	inventoryKind := protobuf.NewInventoryKind(func() protobuf.Inventory {
-		return &inventory.Grain{}
+		return inventory.New(clk)
 	}, 0)
 	timerKind := protobuf.NewTimerKind(func() protobuf.Timer {
-		return &timer.Grain{}
+		return timer.New(clk)
 	}, 0)
 	adminKind := protobuf.NewAdminKind(func() protobuf.Admin {
-		return &admin.Grain{}
+		return admin.New(clk)
 	}, 0)
 	clusterConfig := cluster.Configure(viper.GetString(config.Cluster_Name), provider, lookup, remoteConfig,
 		cluster.WithKinds(inventoryKind, timerKind, adminKind))
@@ -126,6 +132,17 @@ func main() {
 	restoreSnapshots("inventory")
 	restoreSnapshots("timer")
 
+	auth.OnPlayerCreated(activateInventory)
+
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+
+	// Blueprints loaded with architect or changed in the blueprint directory reach the running
+	// nodes without a restart
+	if viper.GetString(config.Registry_Remote_Kind) != config.RegistryMemory {
+		go registry.Watch(watchCtx)
+	}
+
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 
@@ -139,6 +156,8 @@ func main() {
 	go metrics.ServeMetrics(wg)
 	<-sigs
 
+	stopWatch()
+
 	if err := server.Shutdown(context.Background()); err != nil {
 		slog.Warn("failed to stop HTTP server", "error", err)
 	}
@@ -173,6 +192,30 @@ func setupInstrumentation() {
 	}
 }
 
+// clusterProvider returns the in-process provider in local mode and the etcd provider otherwise
+func clusterProvider() (cluster.ClusterProvider, error) {
+	if viper.GetString(config.Cluster_Mode) == config.ClusterModeLocal {
+		slog.Debug("creating in-process provider")
+
+		return test.NewTestProvider(test.NewInMemAgent()), nil
+	}
+
+	etcdConf := clientv3.Config{ // nolint
+		Endpoints:   viper.GetStringSlice(config.ETCD_Endpoints),
+		DialTimeout: 5 * time.Second,
+		DialOptions: []grpc.DialOption{grpc.WithBlock()},
+		Username:    viper.GetString(config.ETCD_User),
+		Password:    viper.GetString(config.ETCD_Passwd),
+	}
+
+	slog.Debug("creating etcd provider",
+		"endpoints", viper.GetStringSlice(config.ETCD_Endpoints),
+		"username", viper.GetString(config.ETCD_User),
+	)
+
+	return etcd.NewWithConfig(viper.GetString(config.ETCD_Root), etcdConf)
+}
+
 func initToken() {
 	if _, err := auth.GetToken(); err != nil {
 		slog.Error("failed to get management access token", err)
@@ -188,8 +231,8 @@ func initDatabase() {
 }
 
 func initTransport() {
-	if _, err := nats.GetConnection(); err != nil {
-		slog.Error("failed to connect to NATS", err)
+	if _, err := transport.Get(); err != nil {
+		slog.Error("failed to set up transport", err)
 		exit(1)
 	}
 }
@@ -200,6 +243,24 @@ func restoreSnapshots(kind string) {
 	}
 }
 
+// activateInventory spawns the inventory of a new player, so it has its starting assets by the
+// time of the first request
+func activateInventory(externalID uuid.UUID) {
+	go func() {
+		if _, err := game.Describe(context.Background(), externalID); err != nil {
+			slog.Warn("failed to activate inventory", "error", err, "external_id", externalID.String())
+		}
+	}()
+}
+
+// initRegistry connects to the remote registry, or loads the blueprint directory into the
+// in-memory one
 func initRegistry() error {
+	if kind := viper.GetString(config.Registry_Remote_Kind); kind == config.RegistryMemory || kind == config.RegistryFile {
+		slog.Debug("loading blueprints", "blueprint_path", viper.GetString(config.Blueprint_Path))
+
+		return registry.Load(viper.GetString(config.Blueprint_Path))
+	}
+
 	return registry.Init()
 }
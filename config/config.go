@@ -21,6 +21,7 @@ var configs = []struct {
 	{PG_SSLMode, "POSTGRES_SSLMODE", "disable"},
 	// Cluster
 	{Cluster_Name, "CLUSTER_NAME", "avalond"},
+	{Cluster_Mode, "CLUSTER_MODE", ClusterModeEtcd},
 	{Node_Host, "CLUSTER_NODE_HOST", "0.0.0.0"},
 	{Node_Port, "CLUSTER_NODE_PORT", 0},
 	// HTTP
@@ -36,10 +37,15 @@ var configs = []struct {
 	{NATS_Port, "NATS_PORT", "4222"},
 	{NATS_User, "NATS_USER", ""},
 	{NATS_Password, "NATS_PASSWORD", ""},
+	{NATS_JetStream, "NATS_JETSTREAM_ENABLED", false},
+	{NATS_JetStream_Stream, "NATS_JETSTREAM_STREAM", "TIMER_CALLBACKS"},
+	// Transport
+	{Transport_Kind, "TRANSPORT_KIND", TransportNATS},
 	// Logging
 	{Logging_Level, "LOGGING_LEVEL", "info"},
 	{Logging_Path, "LOGGING_PATH", ""},
 	// Persistence
+	{Persistence_Kind, "PERSISTENCE_KIND", PersistencePostgres},
 	{Persistence_Encoding, "PERSISTENCE_ENCODING", EncodingGob},
 	// Instrumentation
 	{Instrumentation_Traces_Endpoint, "INSTRUMENTATION_TRACES_ENDPOINT", "localhost:4318"},
@@ -49,10 +55,44 @@ var configs = []struct {
 	{Authenticator_Client_ID, "AUTHENTICATOR_CLIENT_ID", ""},
 	{Authenticator_Client_Secret, "AUTHENTICATOR_CLIENT_SECRET", ""},
 	{Authenticator_Audience, "AUTHENTICATOR_AUDIENCE", ""},
+	{Authenticator_Kind, "AUTHENTICATOR_KIND", AuthenticatorAuth0},
+	{Authenticator_Static_Token, "AUTHENTICATOR_STATIC_TOKEN", ""},
+	{Authenticator_Static_External_ID, "AUTHENTICATOR_STATIC_EXTERNAL_ID", ""},
+	{Authenticator_Local_Algorithm, "AUTHENTICATOR_LOCAL_ALGORITHM", "HS256"},
+	{Authenticator_Local_Secret, "AUTHENTICATOR_LOCAL_SECRET", ""},
+	{Authenticator_Local_Private_Key, "AUTHENTICATOR_LOCAL_PRIVATE_KEY", ""},
+	{Authenticator_Local_Issuer, "AUTHENTICATOR_LOCAL_ISSUER", "avalond"},
+	{Authenticator_Local_Audience, "AUTHENTICATOR_LOCAL_AUDIENCE", "avalond"},
+	{Authenticator_Local_Token_TTL, "AUTHENTICATOR_LOCAL_TOKEN_TTL", "24h"},
+	{Authenticator_Cache_Size, "AUTHENTICATOR_CACHE_SIZE", 10000},
+	{Authenticator_Cache_TTL, "AUTHENTICATOR_CACHE_TTL", "10m"},
+	{Authenticator_Cache_Negative_TTL, "AUTHENTICATOR_CACHE_NEGATIVE_TTL", "30s"},
+	{Authenticator_Onboarding_Write_Back, "AUTHENTICATOR_ONBOARDING_WRITE_BACK", false},
+	// Blueprints
+	{Blueprint_Path, "BLUEPRINT_PATH", "./blueprints"},
 	// Registry
 	{Registry_Remote_Kind, "REGISTRY_REMOTE_KIND", "etcd"},
 	{Registry_Etcd_Key_Root, "REGISTRY_ETCD_KEY_ROOT", "registry"},
 	{Registry_Etcd_Key_Separator, "REGISTRY_ETCD_KEY_SEPARATOR", "/"},
+	// Inventory
+	{Inventory_Build_Slots, "INVENTORY_BUILD_SLOTS", 1},
+	{Inventory_Max_Build_Amount, "INVENTORY_MAX_BUILD_AMOUNT", 3},
+	{Inventory_Production_Mode, "INVENTORY_PRODUCTION_MODE", ProductionModeTimers},
+}
+
+// localDefaults replace the defaults that need external services when the cluster runs in
+// local mode. Settings from the config file or the environment still take precedence.
+var localDefaults = []struct {
+	key string
+	def interface{}
+}{
+	{Node_Host, "127.0.0.1"},
+	{Transport_Kind, TransportMemory},
+	{Persistence_Kind, PersistenceMemory},
+	{Registry_Remote_Kind, RegistryMemory},
+	{Authenticator_Kind, AuthenticatorStatic},
+	{Authenticator_Static_Token, "dev"},
+	{Authenticator_Static_External_ID, "00000000-0000-0000-0000-000000000001"},
 }
 
 func Setup(path string) {
@@ -77,3 +117,13 @@ func Setup(path string) {
 		slog.Warn("failed to read config file, using defaults and env vars", "error", err)
 	}
 }
+
+// SetupLocal switches to the local mode defaults, so a single process runs without etcd, NATS,
+// Postgres or Auth0
+func SetupLocal() {
+	viper.Set(Cluster_Mode, ClusterModeLocal)
+
+	for _, values := range localDefaults {
+		viper.SetDefault(values.key, values.def)
+	}
+}
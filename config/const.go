@@ -16,8 +16,12 @@ const (
 
 const (
 	Cluster_Name = "cluster.name"
+	Cluster_Mode = "cluster.mode"
 	Node_Host    = "cluster.node.host"
 	Node_Port    = "cluster.node.port"
+
+	ClusterModeEtcd  = "etcd"
+	ClusterModeLocal = "local"
 )
 
 const (
@@ -37,6 +41,16 @@ const (
 	NATS_Port     = "nats.port"
 	NATS_User     = "nats.user"
 	NATS_Password = "nats.password"
+
+	NATS_JetStream        = "nats.jetstream.enabled"
+	NATS_JetStream_Stream = "nats.jetstream.stream"
+)
+
+const (
+	Transport_Kind = "transport.kind"
+
+	TransportNATS   = "nats"
+	TransportMemory = "memory"
 )
 
 const (
@@ -45,8 +59,12 @@ const (
 )
 
 const (
+	Persistence_Kind     = "persistence.kind"
 	Persistence_Encoding = "persistence.encoding"
 
+	PersistencePostgres = "postgres"
+	PersistenceMemory   = "memory"
+
 	EncodingGob  = "gob"
 	EncodingJson = "json"
 )
@@ -61,10 +79,47 @@ const (
 	Authenticator_Client_ID     = "authenticator.client_id"
 	Authenticator_Client_Secret = "authenticator.client_secret"
 	Authenticator_Audience      = "authenticator.audience"
+	Authenticator_Kind          = "authenticator.kind"
+
+	Authenticator_Static_Token       = "authenticator.static.token"
+	Authenticator_Static_External_ID = "authenticator.static.external_id"
+
+	Authenticator_Local_Algorithm   = "authenticator.local.algorithm"
+	Authenticator_Local_Secret      = "authenticator.local.secret"
+	Authenticator_Local_Private_Key = "authenticator.local.private_key"
+	Authenticator_Local_Issuer      = "authenticator.local.issuer"
+	Authenticator_Local_Audience    = "authenticator.local.audience"
+	Authenticator_Local_Token_TTL   = "authenticator.local.token_ttl"
+
+	Authenticator_Cache_Size         = "authenticator.cache.size"
+	Authenticator_Cache_TTL          = "authenticator.cache.ttl"
+	Authenticator_Cache_Negative_TTL = "authenticator.cache.negative_ttl"
+
+	Authenticator_Onboarding_Write_Back = "authenticator.onboarding.write_back"
+
+	AuthenticatorAuth0  = "auth0"
+	AuthenticatorStatic = "static"
+	AuthenticatorLocal  = "local"
+)
+
+const (
+	Blueprint_Path = "blueprint_path"
 )
 
 const (
 	Registry_Remote_Kind        = "registry.remote_kind"
 	Registry_Etcd_Key_Root      = "registry.etcd.key_root"
 	Registry_Etcd_Key_Separator = "registry.etcd.key_separator"
+
+	RegistryMemory = "memory"
+	RegistryFile   = "file"
+)
+
+const (
+	Inventory_Build_Slots      = "inventory.build_slots"
+	Inventory_Max_Build_Amount = "inventory.max_build_amount"
+	Inventory_Production_Mode  = "inventory.production_mode"
+
+	ProductionModeTimers = "timers"
+	ProductionModeLazy   = "lazy"
 )
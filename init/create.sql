@@ -29,6 +29,22 @@ CREATE TABLE blueprints (
     id uuid not null,
     kind varchar(255) not null,
     data json not null,
+    deleted boolean not null default false,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE INDEX blueprints_name ON blueprints (kind, (data->>'name'), created_at DESC);
+
+CREATE TABLE users (
+    id uuid primary key,
+    username varchar(255) not null unique,
+    password_hash bytea not null,
+    external_id uuid not null unique,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE players (
+    subject varchar(255) primary key,
+    external_id uuid not null unique,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,5 @@
+-- Blueprints are deleted by adding a row marked as deleted, which databases created before
+-- architect delete don't have a column for.
+ALTER TABLE blueprints ADD COLUMN IF NOT EXISTS deleted boolean not null default false;
+
+CREATE INDEX IF NOT EXISTS blueprints_name ON blueprints (kind, (data->>'name'), created_at DESC);
@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/0xa1-red/empires-of-avalon/persistence/contract"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/asynkron/protoactor-go/cluster"
+	"golang.org/x/exp/slog"
+)
+
+type restorableGrain interface {
+	Restore(r *protobuf.RestoreRequest, opts ...cluster.GrainCallOption) (*protobuf.RestoreResponse, error)
+}
+
+type key struct {
+	kind     string
+	identity string
+}
+
+// Persister keeps the latest snapshot of every grain in memory. The snapshots live as long as
+// the process, which is enough to restore grains that were passivated in a local cluster.
+type Persister struct {
+	mx        sync.RWMutex
+	snapshots map[key][]byte
+	c         *cluster.Cluster
+}
+
+func NewPersister(c *cluster.Cluster) *Persister {
+	return &Persister{ // nolint:exhaustruct
+		snapshots: make(map[key][]byte),
+		c:         c,
+	}
+}
+
+func (p *Persister) Persist(item contract.Persistable) (int, error) {
+	raw, err := item.Encode()
+	if err != nil {
+		return 0, err
+	}
+
+	if raw == nil {
+		return 0, nil
+	}
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.snapshots[key{kind: item.Kind(), identity: item.Identity()}] = raw
+
+	return len(raw), nil
+}
+
+func (p *Persister) Restore(kind, identity string) error {
+	p.mx.RLock()
+
+	matching := make(map[key][]byte)
+
+	for k, data := range p.snapshots {
+		if (kind == "" || k.kind == kind) && (identity == "" || k.identity == identity) {
+			matching[k] = data
+		}
+	}
+
+	p.mx.RUnlock()
+
+	if len(matching) == 0 {
+		slog.Debug("no snapshot found", "kind", kind, "identity", identity)
+		return nil
+	}
+
+	for k, data := range matching {
+		if err := p.restore(k, data); err != nil {
+			slog.Warn("failed to restore snapshot", "kind", k.kind, "identity", k.identity, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Persister) restore(k key, data []byte) error {
+	var client restorableGrain
+
+	switch k.kind {
+	case "inventory":
+		client = protobuf.GetInventoryGrainClient(p.c, k.identity)
+	case "timer":
+		client = protobuf.GetTimerGrainClient(p.c, k.identity)
+	default:
+		return fmt.Errorf("unknown kind %s", k.kind)
+	}
+
+	res, err := client.Restore(&protobuf.RestoreRequest{Data: data})
+	if err != nil {
+		return err
+	}
+
+	if res.Status == protobuf.Status_Error {
+		return fmt.Errorf("%s", res.Error)
+	}
+
+	return nil
+}
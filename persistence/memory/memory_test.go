@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type snapshot struct {
+	identity string
+	data     []byte
+}
+
+func (s snapshot) Kind() string            { return "inventory" }
+func (s snapshot) Identity() string        { return s.identity }
+func (s snapshot) Encode() ([]byte, error) { return s.data, nil }
+
+func TestPersist(t *testing.T) {
+	p := NewPersister(nil)
+
+	n, err := p.Persist(snapshot{identity: "a", data: []byte("first")})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+
+	_, err = p.Persist(snapshot{identity: "a", data: []byte("second")})
+	assert.NoError(t, err)
+
+	n, err = p.Persist(snapshot{identity: "b", data: nil})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	assert.Len(t, p.snapshots, 1)
+	assert.Equal(t, []byte("second"), p.snapshots[key{kind: "inventory", identity: "a"}])
+}
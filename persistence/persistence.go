@@ -1,19 +1,33 @@
 package persistence
 
 import (
+	"github.com/0xa1-red/empires-of-avalon/config"
 	"github.com/0xa1-red/empires-of-avalon/persistence/contract"
+	"github.com/0xa1-red/empires-of-avalon/persistence/memory"
 	"github.com/0xa1-red/empires-of-avalon/persistence/postgres"
 	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/spf13/viper"
 )
 
 var persister contract.PersisterRestorer
 
+// Create sets up the persister selected by the persistence.kind setting
 func Create(c *cluster.Cluster) {
 	if persister == nil {
-		persister = postgres.NewPersister(c)
+		switch viper.GetString(config.Persistence_Kind) {
+		case config.PersistenceMemory:
+			persister = memory.NewPersister(c)
+		default:
+			persister = postgres.NewPersister(c)
+		}
 	}
 }
 
 func Get() contract.PersisterRestorer {
 	return persister
 }
+
+// Set replaces the persister, eg. with one that keeps the snapshots for a test
+func Set(p contract.PersisterRestorer) {
+	persister = p
+}
@@ -0,0 +1,169 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Fake is a manually advanced clock. Timers and tickers created from it only fire when
+// Advance or Set moves the time past their deadline.
+type Fake struct {
+	mx      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*waiter
+}
+
+type waiter struct {
+	clock    *Fake
+	deadline time.Time
+	period   time.Duration
+	c        chan time.Time
+}
+
+func NewFake(now time.Time) *Fake {
+	f := &Fake{ // nolint:exhaustruct
+		now:     now,
+		waiters: make([]*waiter, 0),
+	}
+	f.cond = sync.NewCond(&f.mx)
+
+	return f
+}
+
+func (f *Fake) Now() time.Time {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	return f.now
+}
+
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+func (f *Fake) Until(t time.Time) time.Duration {
+	return t.Sub(f.Now())
+}
+
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	return f.add(d, 0)
+}
+
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+
+	return fakeTicker{f.add(d, d)}
+}
+
+// Advance moves the clock forward, firing every timer and ticker whose deadline passes in
+// deadline order
+func (f *Fake) Advance(d time.Duration) {
+	f.Set(f.Now().Add(d))
+}
+
+// Set moves the clock to t, firing every timer and ticker whose deadline passes in deadline order
+func (f *Fake) Set(t time.Time) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	for {
+		sort.SliceStable(f.waiters, func(i, j int) bool {
+			return f.waiters[i].deadline.Before(f.waiters[j].deadline)
+		})
+
+		if len(f.waiters) == 0 || f.waiters[0].deadline.After(t) {
+			break
+		}
+
+		w := f.waiters[0]
+		f.now = w.deadline
+		w.fire(f.now)
+
+		if w.period > 0 {
+			w.deadline = w.deadline.Add(w.period)
+		} else {
+			f.remove(w)
+		}
+	}
+
+	if t.After(f.now) {
+		f.now = t
+	}
+}
+
+// BlockUntil waits until at least n timers and tickers are waiting on the clock, so a test
+// can be sure a goroutine reached its wait before advancing time
+func (f *Fake) BlockUntil(n int) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+func (f *Fake) add(d, period time.Duration) *waiter {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	w := &waiter{
+		clock:    f,
+		deadline: f.now.Add(d),
+		period:   period,
+		c:        make(chan time.Time, 1),
+	}
+
+	// Like time.NewTimer, a timer without a positive duration fires right away
+	if period == 0 && d <= 0 {
+		w.fire(f.now)
+		return w
+	}
+
+	f.waiters = append(f.waiters, w)
+	f.cond.Broadcast()
+
+	return w
+}
+
+// remove deletes a waiter, the caller has to hold the lock
+func (f *Fake) remove(w *waiter) bool {
+	for i := range f.waiters {
+		if f.waiters[i] == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// fire delivers the tick, dropping it if the previous one wasn't received yet like time.Ticker does
+func (w *waiter) fire(t time.Time) {
+	select {
+	case w.c <- t:
+	default:
+	}
+}
+
+func (w *waiter) C() <-chan time.Time {
+	return w.c
+}
+
+func (w *waiter) Stop() bool {
+	w.clock.mx.Lock()
+	defer w.clock.mx.Unlock()
+
+	return w.clock.remove(w)
+}
+
+type fakeTicker struct {
+	*waiter
+}
+
+func (t fakeTicker) Stop() {
+	t.waiter.Stop()
+}
@@ -0,0 +1,83 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeTimer(t *testing.T) {
+	start := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+
+	timer := c.NewTimer(10 * time.Second)
+
+	c.Advance(9 * time.Second)
+
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired before its deadline")
+	default:
+	}
+
+	c.Advance(5 * time.Second)
+
+	assert.Equal(t, start.Add(10*time.Second), <-timer.C())
+	assert.Equal(t, start.Add(14*time.Second), c.Now())
+	assert.False(t, timer.Stop())
+
+	// Deadlines in the past fire right away, like they do with time.NewTimer
+	past := c.NewTimer(c.Until(start))
+	assert.Equal(t, c.Now(), <-past.C())
+}
+
+func TestFakeTicker(t *testing.T) {
+	start := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+
+	ticker := c.NewTicker(20 * time.Second)
+	defer ticker.Stop()
+
+	ticks := make(chan time.Time, 10)
+	done := make(chan struct{})
+
+	go func() {
+		for i := 0; i < 3; i++ {
+			ticks <- <-ticker.C()
+		}
+		close(done)
+	}()
+
+	for i := 0; i < 3; i++ {
+		c.Advance(20 * time.Second)
+		assert.Equal(t, start.Add(time.Duration(i+1)*20*time.Second), <-ticks)
+	}
+
+	<-done
+
+	// Ticks nobody received are dropped instead of piling up
+	c.Advance(time.Minute)
+	assert.Equal(t, start.Add(80*time.Second), <-ticker.C())
+
+	select {
+	case <-ticker.C():
+		t.Fatal("ticker delivered a dropped tick")
+	default:
+	}
+}
+
+func TestFakeBlockUntil(t *testing.T) {
+	c := NewFake(time.Now())
+	fired := make(chan time.Time)
+
+	go func() {
+		timer := c.NewTimer(time.Minute)
+		fired <- <-timer.C()
+	}()
+
+	c.BlockUntil(1)
+	c.Advance(time.Minute)
+
+	assert.Equal(t, c.Now(), <-fired)
+}
@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 type CommonResponse struct {
 	Status     int
 	StatusText string
@@ -14,5 +16,35 @@ type ErrorResponse struct {
 
 type BuildRequest struct {
 	Building string `json:"building"`
-	Amount   int    `json:"string,omitempty"`
+	Amount   int    `json:"amount,omitempty"`
+}
+
+type AssignWorkersRequest struct {
+	Workers int `json:"workers"`
+}
+
+type BuildingStateRequest struct {
+	Active bool `json:"active"`
+}
+
+type LockedResponse struct {
+	Status     int
+	StatusText string
+	Error      string
+	Reason     map[string]interface{}
+}
+
+type ResearchRequest struct {
+	Research string `json:"research"`
+}
+
+type CredentialsRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type TokenResponse struct {
+	Token      string    `json:"token"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	ExternalID string    `json:"external_id"`
 }
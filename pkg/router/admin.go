@@ -10,6 +10,7 @@ import (
 	"github.com/0xa1-red/empires-of-avalon/actor/admin"
 	"github.com/0xa1-red/empires-of-avalon/pkg/assets"
 	gamecluster "github.com/0xa1-red/empires-of-avalon/pkg/cluster"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
 	"github.com/0xa1-red/empires-of-avalon/protobuf"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/render"
@@ -54,12 +55,20 @@ func adminIndex(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	blueprints, err := registry.GetStatus()
+	if err != nil {
+		E(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
 	data := struct {
-		Timestamp time.Time
-		Data      map[string]any
+		Timestamp  time.Time
+		Data       map[string]any
+		Blueprints registry.Status
 	}{
-		Timestamp: res.Timestamp.AsTime(),
-		Data:      res.Admin.AsMap(),
+		Timestamp:  res.Timestamp.AsTime(),
+		Data:       res.Admin.AsMap(),
+		Blueprints: blueprints,
 	}
 
 	HTML(w, r, t, data)
@@ -0,0 +1,97 @@
+package router
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/model"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/auth"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"golang.org/x/exp/slog"
+)
+
+// AuthRouter serves the accounts of the local authenticator
+func AuthRouter(local *auth.Local) *chi.Mux {
+	r := chi.NewRouter()
+
+	r.Post("/register", register(local))
+	r.Post("/login", login(local))
+
+	return r
+}
+
+func register(local *auth.Local) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		credentials, ok := decodeCredentials(w, r)
+		if !ok {
+			return
+		}
+
+		token, err := local.Register(credentials.Username, credentials.Password)
+		if err != nil {
+			status := http.StatusInternalServerError
+
+			switch {
+			case errors.Is(err, auth.ErrUserExists):
+				status = http.StatusConflict
+			case errors.Is(err, auth.ErrInvalidUsername), errors.Is(err, auth.ErrPasswordTooShort):
+				status = http.StatusBadRequest
+			}
+
+			E(w, r, status, err)
+
+			return
+		}
+
+		slog.Info("registered user", "username", credentials.Username, "external_id", token.ExternalID)
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, tokenResponse(token))
+	}
+}
+
+func login(local *auth.Local) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		credentials, ok := decodeCredentials(w, r)
+		if !ok {
+			return
+		}
+
+		token, err := local.Login(credentials.Username, credentials.Password)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, auth.ErrInvalidCredentials) {
+				status = http.StatusUnauthorized
+			}
+
+			E(w, r, status, err)
+
+			return
+		}
+
+		render.JSON(w, r, tokenResponse(token))
+	}
+}
+
+func decodeCredentials(w http.ResponseWriter, r *http.Request) (model.CredentialsRequest, bool) {
+	decoder := json.NewDecoder(r.Body)
+	defer r.Body.Close()
+
+	var credentials model.CredentialsRequest
+	if err := decoder.Decode(&credentials); err != nil {
+		E(w, r, http.StatusBadRequest, err)
+		return credentials, false
+	}
+
+	return credentials, true
+}
+
+func tokenResponse(token *auth.IssuedToken) model.TokenResponse {
+	return model.TokenResponse{
+		Token:      token.Token,
+		ExpiresAt:  token.ExpiresAt,
+		ExternalID: token.ExternalID,
+	}
+}
@@ -39,9 +39,15 @@ func GameRouter() *chi.Mux {
 	}))
 
 	r.Group(func(r chi.Router) {
-		r.Use(auth.EnsureValidToken())
+		r.Use(auth.Authenticate())
 		r.Get("/inventory", inventory)
 		r.Post("/build", build)
+		r.Delete("/build/{id}", cancelBuild)
+		r.Delete("/buildings/{id}", demolish)
+		r.Put("/buildings/{id}/workers", assignWorkers)
+		r.Put("/buildings/{id}/state", setBuildingState)
+		r.Post("/buildings/{id}/upgrade", upgradeBuilding)
+		r.Post("/research", research)
 	})
 
 	return r
@@ -160,12 +166,19 @@ func build(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if res.Status == protobuf.Status_Error {
+		err := fmt.Errorf("%s", res.Error)
 		span.RecordError(err)
-		slog.Error("failed to start building", fmt.Errorf("%s", res.Error),
+		slog.Error("failed to start building", err,
 			"auth", auth,
 			"url", r.URL.String(),
 			"building", b.Name,
 		)
+
+		if res.Reason != nil {
+			Locked(w, r, err, res.Reason.AsMap())
+			return
+		}
+
 		E(w, r, http.StatusInternalServerError, err)
 
 		return
@@ -179,3 +192,457 @@ func build(w http.ResponseWriter, r *http.Request) {
 	}
 	render.JSON(w, r, resp)
 }
+
+func cancelBuild(w http.ResponseWriter, r *http.Request) {
+	ctx, span := traces.Start(r.Context(), "api/router/cancel_build")
+	defer span.End()
+
+	ctx = context.WithValue(ctx, middleware.RequestIDKey, span.SpanContext().TraceID())
+	r = r.WithContext(ctx)
+
+	w.Header().Set("X-Trace-Id", span.SpanContext().TraceID().String())
+
+	auth := authFromContext(w, r, ctx)
+
+	buildingID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse building ID", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	authUUID, err := uuid.Parse(auth)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse authorization header", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("user_id", authUUID.String()),
+		attribute.String("building_id", buildingID.String()),
+	)
+
+	res, err := game.CancelBuilding(ctx, authUUID, buildingID)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to cancel building", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"building_id", buildingID.String(),
+		)
+		E(w, r, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if res.Status == protobuf.Status_Error {
+		err := fmt.Errorf("%s", res.Error)
+		span.RecordError(err)
+		slog.Error("failed to cancel building", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"building_id", buildingID.String(),
+		)
+		E(w, r, http.StatusNotFound, err)
+
+		return
+	}
+
+	status := http.StatusOK
+	resp := model.CommonResponse{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Message:    "OK",
+	}
+	render.JSON(w, r, resp)
+}
+
+func demolish(w http.ResponseWriter, r *http.Request) {
+	ctx, span := traces.Start(r.Context(), "api/router/demolish")
+	defer span.End()
+
+	ctx = context.WithValue(ctx, middleware.RequestIDKey, span.SpanContext().TraceID())
+	r = r.WithContext(ctx)
+
+	w.Header().Set("X-Trace-Id", span.SpanContext().TraceID().String())
+
+	auth := authFromContext(w, r, ctx)
+
+	buildingID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse building ID", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	authUUID, err := uuid.Parse(auth)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse authorization header", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("user_id", authUUID.String()),
+		attribute.String("building_id", buildingID.String()),
+	)
+
+	res, err := game.Demolish(ctx, authUUID, buildingID)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to demolish building", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"building_id", buildingID.String(),
+		)
+		E(w, r, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if res.Status == protobuf.Status_Error {
+		err := fmt.Errorf("%s", res.Error)
+		span.RecordError(err)
+		slog.Error("failed to demolish building", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"building_id", buildingID.String(),
+		)
+		E(w, r, http.StatusNotFound, err)
+
+		return
+	}
+
+	status := http.StatusOK
+	resp := model.CommonResponse{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Message:    "OK",
+	}
+	render.JSON(w, r, resp)
+}
+
+func assignWorkers(w http.ResponseWriter, r *http.Request) {
+	ctx, span := traces.Start(r.Context(), "api/router/assign_workers")
+	defer span.End()
+
+	ctx = context.WithValue(ctx, middleware.RequestIDKey, span.SpanContext().TraceID())
+	r = r.WithContext(ctx)
+
+	w.Header().Set("X-Trace-Id", span.SpanContext().TraceID().String())
+
+	auth := authFromContext(w, r, ctx)
+
+	buildingID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse building ID", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	defer r.Body.Close()
+
+	var assignRequest model.AssignWorkersRequest
+	if err := decoder.Decode(&assignRequest); err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse request", err,
+			"auth", auth,
+		)
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	authUUID, err := uuid.Parse(auth)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse authorization header", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("user_id", authUUID.String()),
+		attribute.String("building_id", buildingID.String()),
+		attribute.Int("workers", assignRequest.Workers),
+	)
+
+	res, err := game.AssignWorkers(ctx, authUUID, buildingID, assignRequest.Workers)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to assign workers", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"building_id", buildingID.String(),
+		)
+		E(w, r, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if res.Status == protobuf.Status_Error {
+		err := fmt.Errorf("%s", res.Error)
+		span.RecordError(err)
+		slog.Error("failed to assign workers", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"building_id", buildingID.String(),
+		)
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	status := http.StatusOK
+	resp := model.CommonResponse{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Message:    "OK",
+	}
+	render.JSON(w, r, resp)
+}
+
+func setBuildingState(w http.ResponseWriter, r *http.Request) {
+	ctx, span := traces.Start(r.Context(), "api/router/set_building_state")
+	defer span.End()
+
+	ctx = context.WithValue(ctx, middleware.RequestIDKey, span.SpanContext().TraceID())
+	r = r.WithContext(ctx)
+
+	w.Header().Set("X-Trace-Id", span.SpanContext().TraceID().String())
+
+	auth := authFromContext(w, r, ctx)
+
+	buildingID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse building ID", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	defer r.Body.Close()
+
+	var stateRequest model.BuildingStateRequest
+	if err := decoder.Decode(&stateRequest); err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse request", err,
+			"auth", auth,
+		)
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	authUUID, err := uuid.Parse(auth)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse authorization header", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("user_id", authUUID.String()),
+		attribute.String("building_id", buildingID.String()),
+		attribute.Bool("active", stateRequest.Active),
+	)
+
+	res, err := game.SetBuildingActive(ctx, authUUID, buildingID, stateRequest.Active)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to set building state", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"building_id", buildingID.String(),
+		)
+		E(w, r, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if res.Status == protobuf.Status_Error {
+		err := fmt.Errorf("%s", res.Error)
+		span.RecordError(err)
+		slog.Error("failed to set building state", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"building_id", buildingID.String(),
+		)
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	status := http.StatusOK
+	resp := model.CommonResponse{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Message:    "OK",
+	}
+	render.JSON(w, r, resp)
+}
+
+func upgradeBuilding(w http.ResponseWriter, r *http.Request) {
+	ctx, span := traces.Start(r.Context(), "api/router/upgrade_building")
+	defer span.End()
+
+	ctx = context.WithValue(ctx, middleware.RequestIDKey, span.SpanContext().TraceID())
+	r = r.WithContext(ctx)
+
+	w.Header().Set("X-Trace-Id", span.SpanContext().TraceID().String())
+
+	auth := authFromContext(w, r, ctx)
+
+	buildingID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse building ID", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	authUUID, err := uuid.Parse(auth)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse authorization header", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("user_id", authUUID.String()),
+		attribute.String("building_id", buildingID.String()),
+	)
+
+	res, err := game.UpgradeBuilding(ctx, authUUID, buildingID)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to upgrade building", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"building_id", buildingID.String(),
+		)
+		E(w, r, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if res.Status == protobuf.Status_Error {
+		err := fmt.Errorf("%s", res.Error)
+		span.RecordError(err)
+		slog.Error("failed to upgrade building", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"building_id", buildingID.String(),
+		)
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	status := http.StatusOK
+	resp := model.CommonResponse{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Message:    "OK",
+	}
+	render.JSON(w, r, resp)
+}
+
+func research(w http.ResponseWriter, r *http.Request) {
+	ctx, span := traces.Start(r.Context(), "api/router/research")
+	defer span.End()
+
+	ctx = context.WithValue(ctx, middleware.RequestIDKey, span.SpanContext().TraceID())
+	r = r.WithContext(ctx)
+
+	w.Header().Set("X-Trace-Id", span.SpanContext().TraceID().String())
+
+	auth := authFromContext(w, r, ctx)
+
+	decoder := json.NewDecoder(r.Body)
+	defer r.Body.Close()
+
+	var researchRequest model.ResearchRequest
+	if err := decoder.Decode(&researchRequest); err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse request", err,
+			"auth", auth,
+		)
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	span.SetAttributes(attribute.String("research", researchRequest.Research))
+
+	authUUID, err := uuid.Parse(auth)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to parse authorization header", err, "auth", auth, "url", r.URL.String())
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	span.SetAttributes(attribute.String("user_id", authUUID.String()))
+
+	res, err := game.StartResearch(ctx, authUUID, researchRequest.Research)
+	if err != nil {
+		span.RecordError(err)
+		slog.Error("failed to start research", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"research", researchRequest.Research,
+		)
+		E(w, r, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if res.Status == protobuf.Status_Error {
+		err := fmt.Errorf("%s", res.Error)
+		span.RecordError(err)
+		slog.Error("failed to start research", err,
+			"auth", auth,
+			"url", r.URL.String(),
+			"research", researchRequest.Research,
+		)
+
+		if res.Reason != nil {
+			Locked(w, r, err, res.Reason.AsMap())
+			return
+		}
+
+		E(w, r, http.StatusBadRequest, err)
+
+		return
+	}
+
+	status := http.StatusCreated
+	resp := model.CommonResponse{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Message:    "OK",
+	}
+	render.JSON(w, r, resp)
+}
@@ -2,15 +2,12 @@ package router
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"time"
 
 	intmw "github.com/0xa1-red/empires-of-avalon/pkg/middleware"
 	"github.com/0xa1-red/empires-of-avalon/pkg/model"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/auth"
-	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
-	"github.com/auth0/go-jwt-middleware/v2/validator"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/render"
@@ -27,6 +24,12 @@ func New() *chi.Mux {
 	s.Mount("/api", GameRouter())
 	s.Mount("/admin", AdminRouter())
 
+	if a, err := auth.Get(); err == nil {
+		if local, ok := a.(*auth.Local); ok {
+			s.Mount("/auth", AuthRouter(local))
+		}
+	}
+
 	s.Get("/healthz", Healthcheck)
 
 	return s
@@ -45,38 +48,26 @@ func E(w http.ResponseWriter, r *http.Request, status int, err error) {
 	render.JSON(w, r, res)
 }
 
-func authFromContext(w http.ResponseWriter, r *http.Request, ctx context.Context) string {
-	claims := ctx.Value(jwtmiddleware.ContextKey{})
-	if claims == nil {
-		E(w, r, http.StatusInternalServerError, fmt.Errorf("claims not found"))
-		return ""
-	}
-
-	validatedClaims, ok := claims.(*validator.ValidatedClaims)
-	if !ok {
-		E(w, r, http.StatusInternalServerError, fmt.Errorf("failed to validate claims 1"))
-		return ""
-	}
-
-	customClaims, ok := validatedClaims.CustomClaims.(*auth.CustomClaims)
-	if !ok {
-		E(w, r, http.StatusInternalServerError, fmt.Errorf("failed to validate claims 2"))
-		return ""
+// Locked responds with the prerequisites missing to unlock a blueprint
+func Locked(w http.ResponseWriter, r *http.Request, err error, reason map[string]interface{}) {
+	status := http.StatusForbidden
+	res := model.LockedResponse{
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Error:      err.Error(),
+		Reason:     reason,
 	}
 
-	id := customClaims.Subject
+	render.Status(r, status)
+	render.JSON(w, r, res)
+}
 
-	profile, err := auth.GetUserProfile(id)
+func authFromContext(w http.ResponseWriter, r *http.Request, ctx context.Context) string {
+	externalID, err := auth.ExternalIDFromContext(ctx)
 	if err != nil {
 		E(w, r, http.StatusInternalServerError, err)
 		return ""
 	}
 
-	if metadata, ok := profile["app_metadata"].(map[string]interface{}); ok {
-		if eid, ok := metadata["external_id"].(string); ok {
-			return eid
-		}
-	}
-
-	return ""
+	return externalID
 }
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+)
+
+// Auth0 verifies the tokens of an Auth0 tenant. The players of the users are kept in the players
+// table, users without one get theirs from the app_metadata of their profile through the
+// management API, or a new one on their first login.
+type Auth0 struct {
+	profiles *ProfileCache
+}
+
+func NewAuth0(players PlayerStore) (*Auth0, error) {
+	ttl, err := time.ParseDuration(viper.GetString(config.Authenticator_Cache_TTL))
+	if err != nil {
+		return nil, err
+	}
+
+	negativeTTL, err := time.ParseDuration(viper.GetString(config.Authenticator_Cache_Negative_TTL))
+	if err != nil {
+		return nil, err
+	}
+
+	var writeBack func(string, uuid.UUID) error
+	if viper.GetBool(config.Authenticator_Onboarding_Write_Back) {
+		writeBack = writeExternalID
+	}
+
+	onboarder := NewOnboarder(players, profileExternalID, writeBack)
+
+	return &Auth0{
+		profiles: NewProfileCache(onboarder.ExternalID,
+			viper.GetInt(config.Authenticator_Cache_Size),
+			ttl,
+			negativeTTL,
+			clock.Real(),
+		),
+	}, nil
+}
+
+func (a *Auth0) Middleware() func(next http.Handler) http.Handler {
+	return EnsureValidToken()
+}
+
+func (a *Auth0) ExternalID(claims *CustomClaims) (string, error) {
+	return a.profiles.ExternalID(claims.Subject)
+}
+
+// profileExternalID reads the player from the app_metadata of the user profile
+func profileExternalID(subject string) (string, error) {
+	profile, err := GetUserProfile(subject)
+	if err != nil {
+		return "", err
+	}
+
+	if metadata, ok := profile["app_metadata"].(map[string]interface{}); ok {
+		if eid, ok := metadata["external_id"].(string); ok {
+			return eid, nil
+		}
+	}
+
+	return "", nil
+}
+
+func writeExternalID(subject string, externalID uuid.UUID) error {
+	return UpdateAppMetadata(subject, map[string]interface{}{
+		"external_id": externalID.String(),
+	})
+}
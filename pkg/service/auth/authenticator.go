@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/database"
+	"github.com/spf13/viper"
+)
+
+var (
+	ErrClaimsNotFound = errors.New("claims not found")
+	ErrInvalidClaims  = errors.New("failed to validate claims")
+)
+
+// Authenticator verifies the bearer tokens of the API and maps them to players
+type Authenticator interface {
+	// Middleware rejects requests without a valid token and stores the claims of the token in
+	// the request context
+	Middleware() func(next http.Handler) http.Handler
+	// ExternalID returns the player the claims belong to, or an empty string if there's no
+	// player for them yet
+	ExternalID(claims *CustomClaims) (string, error)
+}
+
+var (
+	mx            sync.Mutex
+	authenticator Authenticator
+)
+
+// Get returns the authenticator selected by the authenticator.kind setting
+func Get() (Authenticator, error) {
+	mx.Lock()
+	defer mx.Unlock()
+
+	if authenticator != nil {
+		return authenticator, nil
+	}
+
+	switch kind := viper.GetString(config.Authenticator_Kind); kind {
+	case config.AuthenticatorAuth0:
+		a, err := NewAuth0(NewPlayerStore(database.Connection()))
+		if err != nil {
+			return nil, err
+		}
+
+		authenticator = a
+	case config.AuthenticatorStatic:
+		a, err := NewStatic()
+		if err != nil {
+			return nil, err
+		}
+
+		authenticator = a
+	case config.AuthenticatorLocal:
+		a, err := NewLocal(NewUserStore(database.Connection()))
+		if err != nil {
+			return nil, err
+		}
+
+		authenticator = a
+	default:
+		return nil, fmt.Errorf("unknown authenticator kind: %s", kind)
+	}
+
+	return authenticator, nil
+}
+
+// Set replaces the authenticator, eg. with one using a test key
+func Set(a Authenticator) {
+	mx.Lock()
+	defer mx.Unlock()
+
+	authenticator = a
+}
@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/instrumentation/metrics"
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/exp/slog"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	resultHit         = "hit"
+	resultNegativeHit = "negative_hit"
+	resultMiss        = "miss"
+)
+
+// LookupFunc fetches the player of a subject from the identity provider. It returns an empty
+// string if the subject has no player.
+type LookupFunc func(subject string) (string, error)
+
+// ProfileCache remembers the players of the subjects, so the identity provider is only asked
+// once per TTL. Subjects without a player are remembered too, for a shorter while. Concurrent
+// lookups of the same subject share a single request, and the least recently used subjects are
+// evicted above the size limit. Failed lookups aren't cached.
+type ProfileCache struct {
+	mx      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // front is the most recently used
+
+	size        int
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	lookup LookupFunc
+	group  singleflight.Group
+	clock  clock.Clock
+
+	lookups   metric.Int64Counter
+	evictions metric.Int64Counter
+}
+
+type cacheEntry struct {
+	subject    string
+	externalID string
+	expiresAt  time.Time
+}
+
+func NewProfileCache(lookup LookupFunc, size int, ttl, negativeTTL time.Duration, clk clock.Clock) *ProfileCache {
+	c := &ProfileCache{ // nolint:exhaustruct
+		entries:     make(map[string]*list.Element),
+		order:       list.New(),
+		size:        size,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		lookup:      lookup,
+		clock:       clk,
+	}
+
+	var err error
+	if c.lookups, err = metrics.Meter().Int64Counter("auth_profile_cache_lookups",
+		metric.WithDescription("Profile lookups by result, the hit rate is hit+negative_hit over all of them"),
+	); err != nil {
+		slog.Warn("failed to register auth_profile_cache_lookups instrument", "error", err)
+	}
+
+	if c.evictions, err = metrics.Meter().Int64Counter("auth_profile_cache_evictions"); err != nil {
+		slog.Warn("failed to register auth_profile_cache_evictions instrument", "error", err)
+	}
+
+	return c
+}
+
+// ExternalID returns the player of a subject, from the cache if possible
+func (c *ProfileCache) ExternalID(subject string) (string, error) {
+	if externalID, ok := c.get(subject); ok {
+		return externalID, nil
+	}
+
+	v, err, _ := c.group.Do(subject, func() (interface{}, error) {
+		// A concurrent lookup might have finished while this one was waiting
+		if externalID, ok := c.peek(subject); ok {
+			return externalID, nil
+		}
+
+		externalID, err := c.lookup(subject)
+		if err != nil {
+			return "", err
+		}
+
+		c.put(subject, externalID)
+
+		return externalID, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil // nolint:forcetypeassert
+}
+
+// Invalidate forgets a subject, eg. after its player was changed
+func (c *ProfileCache) Invalidate(subject string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if el, ok := c.entries[subject]; ok {
+		c.remove(el)
+	}
+}
+
+// get looks the subject up and records the result
+func (c *ProfileCache) get(subject string) (string, bool) {
+	externalID, ok := c.peek(subject)
+
+	result := resultMiss
+
+	switch {
+	case ok && externalID == "":
+		result = resultNegativeHit
+	case ok:
+		result = resultHit
+	}
+
+	c.record(c.lookups, attribute.String("result", result))
+
+	return externalID, ok
+}
+
+func (c *ProfileCache) peek(subject string) (string, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	el, ok := c.entries[subject]
+	if !ok {
+		return "", false
+	}
+
+	entry := el.Value.(*cacheEntry) // nolint:forcetypeassert
+	if !c.clock.Now().Before(entry.expiresAt) {
+		c.remove(el)
+		return "", false
+	}
+
+	c.order.MoveToFront(el)
+
+	return entry.externalID, true
+}
+
+func (c *ProfileCache) put(subject, externalID string) {
+	if c.size <= 0 {
+		return
+	}
+
+	ttl := c.ttl
+	if externalID == "" {
+		ttl = c.negativeTTL
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	entry := &cacheEntry{
+		subject:    subject,
+		externalID: externalID,
+		expiresAt:  c.clock.Now().Add(ttl),
+	}
+
+	if el, ok := c.entries[subject]; ok {
+		el.Value = entry
+		c.order.MoveToFront(el)
+
+		return
+	}
+
+	c.entries[subject] = c.order.PushFront(entry)
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+		c.record(c.evictions)
+	}
+}
+
+func (c *ProfileCache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*cacheEntry).subject) // nolint:forcetypeassert
+}
+
+func (c *ProfileCache) record(counter metric.Int64Counter, attrs ...attribute.KeyValue) {
+	if counter == nil {
+		return
+	}
+
+	counter.Add(context.Background(), 1, metric.WithAttributes(attrs...))
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/clock"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingLookup struct {
+	calls   atomic.Int64
+	players map[string]string
+	err     error
+	wait    chan struct{}
+}
+
+func (l *countingLookup) lookup(subject string) (string, error) {
+	l.calls.Add(1)
+
+	if l.wait != nil {
+		<-l.wait
+	}
+
+	if l.err != nil {
+		return "", l.err
+	}
+
+	return l.players[subject], nil
+}
+
+func TestProfileCacheTTL(t *testing.T) {
+	clk := clock.NewFake(time.Now())
+	l := &countingLookup{players: map[string]string{"a": "player-a"}} // nolint:exhaustruct
+	c := NewProfileCache(l.lookup, 10, time.Minute, 10*time.Second, clk)
+
+	for i := 0; i < 3; i++ {
+		eid, err := c.ExternalID("a")
+		assert.NoError(t, err)
+		assert.Equal(t, "player-a", eid)
+	}
+
+	assert.Equal(t, int64(1), l.calls.Load())
+
+	clk.Advance(time.Minute)
+
+	_, err := c.ExternalID("a")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), l.calls.Load())
+}
+
+func TestProfileCacheNegative(t *testing.T) {
+	clk := clock.NewFake(time.Now())
+	l := &countingLookup{players: map[string]string{}} // nolint:exhaustruct
+	c := NewProfileCache(l.lookup, 10, time.Minute, 10*time.Second, clk)
+
+	eid, err := c.ExternalID("unknown")
+	assert.NoError(t, err)
+	assert.Equal(t, "", eid)
+
+	_, _ = c.ExternalID("unknown") // nolint:errcheck
+	assert.Equal(t, int64(1), l.calls.Load())
+
+	// Unknown users expire sooner, so a new player shows up quickly
+	clk.Advance(10 * time.Second)
+	l.players["unknown"] = "player"
+
+	eid, err = c.ExternalID("unknown")
+	assert.NoError(t, err)
+	assert.Equal(t, "player", eid)
+	assert.Equal(t, int64(2), l.calls.Load())
+}
+
+func TestProfileCacheErrorsAreNotCached(t *testing.T) {
+	l := &countingLookup{err: errors.New("rate limited")} // nolint:exhaustruct
+	c := NewProfileCache(l.lookup, 10, time.Minute, 10*time.Second, clock.NewFake(time.Now()))
+
+	_, err := c.ExternalID("a")
+	assert.Error(t, err)
+
+	_, err = c.ExternalID("a")
+	assert.Error(t, err)
+	assert.Equal(t, int64(2), l.calls.Load())
+}
+
+func TestProfileCacheEviction(t *testing.T) {
+	players := make(map[string]string)
+	for i := 0; i < 3; i++ {
+		players[fmt.Sprint(i)] = fmt.Sprintf("player-%d", i)
+	}
+
+	l := &countingLookup{players: players} // nolint:exhaustruct
+	c := NewProfileCache(l.lookup, 2, time.Minute, 10*time.Second, clock.NewFake(time.Now()))
+
+	_, _ = c.ExternalID("0") // nolint:errcheck
+	_, _ = c.ExternalID("1") // nolint:errcheck
+	_, _ = c.ExternalID("0") // nolint:errcheck
+	_, _ = c.ExternalID("2") // nolint:errcheck
+
+	assert.Equal(t, 2, c.order.Len())
+	assert.Contains(t, c.entries, "0")
+	assert.NotContains(t, c.entries, "1", "the least recently used subject should be evicted")
+}
+
+func TestProfileCacheSingleflight(t *testing.T) {
+	l := &countingLookup{ // nolint:exhaustruct
+		players: map[string]string{"a": "player-a"},
+		wait:    make(chan struct{}),
+	}
+	c := NewProfileCache(l.lookup, 10, time.Minute, 10*time.Second, clock.NewFake(time.Now()))
+
+	wg := &sync.WaitGroup{}
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			eid, err := c.ExternalID("a")
+			assert.NoError(t, err)
+			assert.Equal(t, "player-a", eid)
+		}()
+	}
+
+	// Let the first lookup wait long enough for the others to join it
+	time.Sleep(50 * time.Millisecond)
+	close(l.wait)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), l.calls.Load())
+}
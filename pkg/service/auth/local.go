@@ -0,0 +1,259 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	// minSecretLength is the shortest HS256 secret accepted, as long as the hash itself
+	minSecretLength   = 32
+	minPasswordLength = 8
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrInvalidUsername    = errors.New("username can't be empty")
+	ErrPasswordTooShort   = fmt.Errorf("password has to be at least %d characters", minPasswordLength)
+	ErrSecretTooShort     = fmt.Errorf("HS256 secret has to be at least %d bytes", minSecretLength)
+	ErrInvalidPrivateKey  = errors.New("private key has to be a PEM encoded RSA key")
+	ErrMissingExternalID  = errors.New("token has no external_id claim")
+)
+
+// IssuedToken is a token signed by the local authenticator
+type IssuedToken struct {
+	Token      string
+	ExpiresAt  time.Time
+	ExternalID string
+}
+
+// Local issues and verifies its own tokens, signed with HS256 and a shared secret or with RS256
+// and a local private key. The player of the account is embedded in the external_id claim, so
+// there's nothing to look up once the token is issued.
+type Local struct {
+	algorithm       validator.SignatureAlgorithm
+	signingKey      interface{}
+	verificationKey interface{}
+	issuer          string
+	audience        string
+	ttl             time.Duration
+	users           UserStore
+	validator       *validator.Validator
+
+	// dummyHash is compared against on logins of unknown users, so they take as long as the
+	// logins with a wrong password
+	dummyHash []byte
+}
+
+func NewLocal(users UserStore) (*Local, error) {
+	ttl, err := time.ParseDuration(viper.GetString(config.Authenticator_Local_Token_TTL))
+	if err != nil {
+		return nil, fmt.Errorf("token_ttl: %w", err)
+	}
+
+	dummyHash, err := bcrypt.GenerateFromPassword([]byte(uuid.New().String()), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Local{ // nolint:exhaustruct
+		algorithm: validator.SignatureAlgorithm(strings.ToUpper(viper.GetString(config.Authenticator_Local_Algorithm))),
+		issuer:    viper.GetString(config.Authenticator_Local_Issuer),
+		audience:  viper.GetString(config.Authenticator_Local_Audience),
+		ttl:       ttl,
+		users:     users,
+		dummyHash: dummyHash,
+	}
+
+	switch l.algorithm {
+	case validator.HS256:
+		secret := []byte(viper.GetString(config.Authenticator_Local_Secret))
+		if len(secret) < minSecretLength {
+			return nil, ErrSecretTooShort
+		}
+
+		l.signingKey = secret
+		l.verificationKey = secret
+	case validator.RS256:
+		key, err := readPrivateKey(viper.GetString(config.Authenticator_Local_Private_Key))
+		if err != nil {
+			return nil, err
+		}
+
+		l.signingKey = key
+		l.verificationKey = &key.PublicKey
+	default:
+		return nil, fmt.Errorf("unsupported algorithm: %s", l.algorithm)
+	}
+
+	l.validator, err = validator.New(
+		func(context.Context) (interface{}, error) {
+			return l.verificationKey, nil
+		},
+		l.algorithm,
+		l.issuer,
+		[]string{l.audience},
+		validator.WithCustomClaims(
+			func() validator.CustomClaims {
+				return &CustomClaims{} // nolint:exhaustruct
+			},
+		),
+		validator.WithAllowedClockSkew(time.Minute),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (l *Local) Middleware() func(next http.Handler) http.Handler {
+	middleware := jwtmiddleware.New(
+		l.validator.ValidateToken,
+		jwtmiddleware.WithErrorHandler(errorHandler),
+	)
+
+	return func(next http.Handler) http.Handler {
+		return middleware.CheckJWT(next)
+	}
+}
+
+func (l *Local) ExternalID(claims *CustomClaims) (string, error) {
+	if claims.ExternalID == "" {
+		return "", ErrMissingExternalID
+	}
+
+	return claims.ExternalID, nil
+}
+
+// Register creates an account with a new player and issues a token for it
+func (l *Local) Register(username, password string) (*IssuedToken, error) {
+	if username == "" {
+		return nil, ErrInvalidUsername
+	}
+
+	if len(password) < minPasswordLength {
+		return nil, ErrPasswordTooShort
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &User{
+		ID:           uuid.New(),
+		Username:     username,
+		PasswordHash: hash,
+		ExternalID:   uuid.New(),
+		CreatedAt:    time.Now(),
+	}
+
+	if err := l.users.Create(user); err != nil {
+		return nil, err
+	}
+
+	notifyPlayerCreated(user.ExternalID)
+
+	return l.Issue(user)
+}
+
+// Login checks the password of an account and issues a token for it
+func (l *Local) Login(username, password string) (*IssuedToken, error) {
+	user, err := l.users.Find(username)
+	if errors.Is(err, ErrUserNotFound) {
+		bcrypt.CompareHashAndPassword(l.dummyHash, []byte(password)) // nolint:errcheck
+
+		return nil, ErrInvalidCredentials
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return l.Issue(user)
+}
+
+// Issue signs a token for an account
+func (l *Local) Issue(user *User) (*IssuedToken, error) {
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.SignatureAlgorithm(l.algorithm),
+		Key:       l.signingKey,
+	}, (&jose.SignerOptions{}).WithType("JWT")) // nolint:exhaustruct
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(l.ttl)
+
+	token, err := jwt.Signed(signer).
+		Claims(jwt.Claims{ // nolint:exhaustruct
+			Issuer:   l.issuer,
+			Subject:  user.ID.String(),
+			Audience: jwt.Audience{l.audience},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(expiresAt),
+		}).
+		Claims(CustomClaims{ // nolint:exhaustruct
+			Subject:    user.ID.String(),
+			ExternalID: user.ExternalID.String(),
+		}).
+		CompactSerialize()
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssuedToken{
+		Token:      token,
+		ExpiresAt:  expiresAt,
+		ExternalID: user.ExternalID.String(),
+	}, nil
+}
+
+func readPrivateKey(path string) (*rsa.PrivateKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPrivateKey, err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	return rsaKey, nil
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryUserStore struct {
+	mx    sync.Mutex
+	users map[string]*User
+}
+
+func (s *memoryUserStore) Create(user *User) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if _, ok := s.users[user.Username]; ok {
+		return ErrUserExists
+	}
+
+	s.users[user.Username] = user
+
+	return nil
+}
+
+func (s *memoryUserStore) Find(username string) (*User, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	user, ok := s.users[username]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func newLocal(t *testing.T) *Local {
+	t.Helper()
+
+	l, err := NewLocal(&memoryUserStore{users: make(map[string]*User)}) // nolint:exhaustruct
+	require.NoError(t, err)
+
+	Set(l)
+	t.Cleanup(func() { Set(nil) })
+
+	return l
+}
+
+// authenticate sends a request with the token through the middleware and returns the status
+// and the player the handler saw
+func authenticate(l *Local, token string) (int, string) {
+	externalID := ""
+
+	handler := l.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		externalID, _ = ExternalIDFromContext(r.Context()) // nolint:errcheck
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/inventory", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec.Code, externalID
+}
+
+func TestLocalHS256(t *testing.T) {
+	config.Setup("")
+	viper.Set(config.Authenticator_Local_Algorithm, "HS256")
+	viper.Set(config.Authenticator_Local_Secret, "0123456789abcdef0123456789abcdef")
+
+	l := newLocal(t)
+
+	issued, err := l.Register("player", "correct horse")
+	require.NoError(t, err)
+
+	code, externalID := authenticate(l, issued.Token)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, issued.ExternalID, externalID)
+
+	_, err = l.Register("player", "another password")
+	assert.ErrorIs(t, err, ErrUserExists)
+
+	_, err = l.Login("player", "wrong password")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = l.Login("nobody", "correct horse")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	loggedIn, err := l.Login("player", "correct horse")
+	require.NoError(t, err)
+	assert.Equal(t, issued.ExternalID, loggedIn.ExternalID)
+
+	code, _ = authenticate(l, loggedIn.Token+"x")
+	assert.Equal(t, http.StatusUnauthorized, code)
+}
+
+func TestLocalRS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "key.pem")
+	err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{ // nolint:exhaustruct
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}), 0o600)
+	require.NoError(t, err)
+
+	config.Setup("")
+	viper.Set(config.Authenticator_Local_Algorithm, "RS256")
+	viper.Set(config.Authenticator_Local_Private_Key, path)
+
+	l := newLocal(t)
+
+	issued, err := l.Register("player", "correct horse")
+	require.NoError(t, err)
+
+	code, externalID := authenticate(l, issued.Token)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, issued.ExternalID, externalID)
+}
+
+func TestLocalShortSecret(t *testing.T) {
+	config.Setup("")
+	viper.Set(config.Authenticator_Local_Algorithm, "HS256")
+	viper.Set(config.Authenticator_Local_Secret, "short")
+
+	_, err := NewLocal(&memoryUserStore{users: make(map[string]*User)}) // nolint:exhaustruct
+	assert.ErrorIs(t, err, ErrSecretTooShort)
+}
@@ -99,6 +99,13 @@ func GetUserProfile(id string) (map[string]interface{}, error) {
 
 	defer res.Body.Close()
 
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return nil, ErrUserNotFound
+	case res.StatusCode >= http.StatusBadRequest:
+		return nil, fmt.Errorf("management API responded with %s", res.Status)
+	}
+
 	profile := make(map[string]interface{})
 
 	decoder := json.NewDecoder(res.Body)
@@ -108,3 +115,44 @@ func GetUserProfile(id string) (map[string]interface{}, error) {
 
 	return profile, nil
 }
+
+// UpdateAppMetadata merges metadata into the app_metadata of a user profile
+func UpdateAppMetadata(id string, metadata map[string]interface{}) error {
+	managementURL := fmt.Sprintf("https://%s/api/v2/users/%s",
+		viper.GetString(config.Authenticator_Domain),
+		id,
+	)
+
+	w := bytes.NewBuffer([]byte(""))
+
+	encoder := json.NewEncoder(w)
+	if err := encoder.Encode(map[string]interface{}{"app_metadata": metadata}); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, managementURL, w)
+	if err != nil {
+		return err
+	}
+
+	token, err := GetToken()
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s %s", token.Kind, token.Token))
+	req.Header.Add("content-type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("management API responded with %s", res.Status)
+	}
+
+	return nil
+}
@@ -19,6 +19,8 @@ import (
 type CustomClaims struct {
 	Scope   string `json:"scope"`
 	Subject string `json:"sub"`
+	// ExternalID is the player the token belongs to, if the authenticator knows it already
+	ExternalID string `json:"external_id,omitempty"`
 }
 
 // Validate does nothing for this example, but we need
@@ -27,6 +29,41 @@ func (c CustomClaims) Validate(ctx context.Context) error {
 	return nil
 }
 
+// Authenticate returns the middleware of the authenticator selected by the authenticator.kind setting
+func Authenticate() func(next http.Handler) http.Handler {
+	a, err := Get()
+	if err != nil {
+		log.Fatalf("Failed to set up the authenticator: %v", err)
+	}
+
+	return a.Middleware()
+}
+
+// ExternalIDFromContext returns the player of a request that went through the middleware
+func ExternalIDFromContext(ctx context.Context) (string, error) {
+	claims := ctx.Value(jwtmiddleware.ContextKey{})
+	if claims == nil {
+		return "", ErrClaimsNotFound
+	}
+
+	validatedClaims, ok := claims.(*validator.ValidatedClaims)
+	if !ok {
+		return "", ErrInvalidClaims
+	}
+
+	customClaims, ok := validatedClaims.CustomClaims.(*CustomClaims)
+	if !ok {
+		return "", ErrInvalidClaims
+	}
+
+	a, err := Get()
+	if err != nil {
+		return "", err
+	}
+
+	return a.ExternalID(customClaims)
+}
+
 // EnsureValidToken is a middleware that will check the validity of our JWT.
 func EnsureValidToken() func(next http.Handler) http.Handler {
 	issuerURL, err := url.Parse("https://" + viper.GetString(config.Authenticator_Domain) + "/")
@@ -52,17 +89,6 @@ func EnsureValidToken() func(next http.Handler) http.Handler {
 		log.Fatalf("Failed to set up the jwt validator")
 	}
 
-	errorHandler := func(w http.ResponseWriter, r *http.Request, err error) {
-		log.Printf("Encountered error while validating JWT: %v", err)
-
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusUnauthorized)
-
-		if _, err := w.Write([]byte(`{"message":"Failed to validate JWT."}`)); err != nil {
-			slog.Error("failed to write response", err)
-		}
-	}
-
 	middleware := jwtmiddleware.New(
 		jwtValidator.ValidateToken,
 		jwtmiddleware.WithErrorHandler(errorHandler),
@@ -72,3 +98,14 @@ func EnsureValidToken() func(next http.Handler) http.Handler {
 		return middleware.CheckJWT(next)
 	}
 }
+
+func errorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	log.Printf("Encountered error while validating JWT: %v", err)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+
+	if _, err := w.Write([]byte(`{"message":"Failed to validate JWT."}`)); err != nil {
+		slog.Error("failed to write response", err)
+	}
+}
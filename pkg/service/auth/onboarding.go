@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+
+	"github.com/0xa1-red/empires-of-avalon/database"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slog"
+)
+
+var ErrPlayerNotFound = errors.New("player not found")
+
+var (
+	hookMx        sync.RWMutex
+	playerCreated func(externalID uuid.UUID)
+)
+
+// OnPlayerCreated registers a function that's called with every new player, eg. to activate
+// their inventory before the first request arrives
+func OnPlayerCreated(f func(externalID uuid.UUID)) {
+	hookMx.Lock()
+	defer hookMx.Unlock()
+
+	playerCreated = f
+}
+
+func notifyPlayerCreated(externalID uuid.UUID) {
+	hookMx.RLock()
+	defer hookMx.RUnlock()
+
+	if playerCreated != nil {
+		playerCreated(externalID)
+	}
+}
+
+// PlayerStore maps the subjects of the identity provider to players
+type PlayerStore interface {
+	Find(subject string) (uuid.UUID, error)
+	// Claim stores the player of a subject unless it has one already, and returns the one stored
+	Claim(subject string, externalID uuid.UUID) (uuid.UUID, error)
+}
+
+// PostgresPlayerStore keeps the players in the players table
+type PostgresPlayerStore struct {
+	db *database.Conn
+}
+
+func NewPlayerStore(db *database.Conn) *PostgresPlayerStore {
+	return &PostgresPlayerStore{
+		db: db,
+	}
+}
+
+func (s *PostgresPlayerStore) Find(subject string) (uuid.UUID, error) {
+	var externalID uuid.UUID
+
+	err := s.db.Get(&externalID, "SELECT external_id FROM players WHERE subject = $1", subject)
+	if errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, ErrPlayerNotFound
+	} else if err != nil {
+		return uuid.Nil, err
+	}
+
+	return externalID, nil
+}
+
+func (s *PostgresPlayerStore) Claim(subject string, externalID uuid.UUID) (uuid.UUID, error) {
+	// Another node might onboard the same subject at the same time, the first one wins
+	if _, err := s.db.Exec("INSERT INTO players (subject, external_id) VALUES ($1, $2) ON CONFLICT (subject) DO NOTHING",
+		subject,
+		externalID,
+	); err != nil {
+		return uuid.Nil, err
+	}
+
+	return s.Find(subject)
+}
+
+// Onboarder finds the players of the subjects, and provisions a new one on their first login
+type Onboarder struct {
+	players PlayerStore
+	// profile returns the player the identity provider knows about, if any
+	profile func(subject string) (string, error)
+	// writeBack stores a new player at the identity provider, nil if it shouldn't be
+	writeBack func(subject string, externalID uuid.UUID) error
+}
+
+func NewOnboarder(players PlayerStore, profile func(string) (string, error), writeBack func(string, uuid.UUID) error) *Onboarder {
+	return &Onboarder{
+		players:   players,
+		profile:   profile,
+		writeBack: writeBack,
+	}
+}
+
+// ExternalID returns the player of a subject, creating it if the subject has none yet
+func (o *Onboarder) ExternalID(subject string) (string, error) {
+	externalID, err := o.players.Find(subject)
+	if err == nil {
+		return externalID.String(), nil
+	} else if !errors.Is(err, ErrPlayerNotFound) {
+		return "", err
+	}
+
+	known, err := o.profile(subject)
+	if errors.Is(err, ErrUserNotFound) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	// Players set up at the identity provider keep their ID
+	if known != "" {
+		candidate, err := uuid.Parse(known)
+		if err != nil {
+			return "", err
+		}
+
+		stored, err := o.players.Claim(subject, candidate)
+		if err != nil {
+			return "", err
+		}
+
+		return stored.String(), nil
+	}
+
+	candidate := uuid.New()
+
+	stored, err := o.players.Claim(subject, candidate)
+	if err != nil {
+		return "", err
+	}
+
+	if stored != candidate {
+		return stored.String(), nil
+	}
+
+	slog.Info("onboarded player", "subject", subject, "external_id", stored.String())
+
+	if o.writeBack != nil {
+		if err := o.writeBack(subject, stored); err != nil {
+			slog.Warn("failed to write the player back to the identity provider", "error", err, "subject", subject)
+		}
+	}
+
+	notifyPlayerCreated(stored)
+
+	return stored.String(), nil
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryPlayerStore struct {
+	mx      sync.Mutex
+	players map[string]uuid.UUID
+}
+
+func (s *memoryPlayerStore) Find(subject string) (uuid.UUID, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	externalID, ok := s.players[subject]
+	if !ok {
+		return uuid.Nil, ErrPlayerNotFound
+	}
+
+	return externalID, nil
+}
+
+func (s *memoryPlayerStore) Claim(subject string, externalID uuid.UUID) (uuid.UUID, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if existing, ok := s.players[subject]; ok {
+		return existing, nil
+	}
+
+	s.players[subject] = externalID
+
+	return externalID, nil
+}
+
+func TestOnboarding(t *testing.T) {
+	known := uuid.New()
+	profiles := map[string]string{
+		"known":    known.String(),
+		"new-user": "",
+	}
+
+	players := &memoryPlayerStore{players: make(map[string]uuid.UUID)} // nolint:exhaustruct
+	writtenBack := make(map[string]uuid.UUID)
+	created := make([]uuid.UUID, 0)
+
+	OnPlayerCreated(func(externalID uuid.UUID) {
+		created = append(created, externalID)
+	})
+	t.Cleanup(func() { OnPlayerCreated(nil) })
+
+	o := NewOnboarder(players,
+		func(subject string) (string, error) {
+			eid, ok := profiles[subject]
+			if !ok {
+				return "", ErrUserNotFound
+			}
+
+			return eid, nil
+		},
+		func(subject string, externalID uuid.UUID) error {
+			writtenBack[subject] = externalID
+			return nil
+		},
+	)
+
+	// Players set up at the identity provider are imported as they are
+	eid, err := o.ExternalID("known")
+	assert.NoError(t, err)
+	assert.Equal(t, known.String(), eid)
+	assert.Empty(t, created)
+
+	// New users get a new player, once
+	eid, err = o.ExternalID("new-user")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, eid)
+
+	again, err := o.ExternalID("new-user")
+	assert.NoError(t, err)
+	assert.Equal(t, eid, again)
+
+	assert.Equal(t, []uuid.UUID{uuid.MustParse(eid)}, created)
+	assert.Equal(t, map[string]uuid.UUID{"new-user": uuid.MustParse(eid)}, writtenBack)
+
+	// Unknown users don't get a player
+	eid, err = o.ExternalID("unknown")
+	assert.NoError(t, err)
+	assert.Equal(t, "", eid)
+	assert.NotContains(t, players.players, "unknown")
+}
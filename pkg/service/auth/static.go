@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+)
+
+// StaticSubject is the subject of the requests authenticated with the static token
+const StaticSubject = "static"
+
+var (
+	ErrInvalidStaticToken = errors.New("invalid static token")
+	ErrMissingStaticToken = errors.New("static authenticator needs a token")
+)
+
+// Static accepts a single bearer token from the config and authenticates every request carrying
+// it as the same player. It's meant for local development only, where there's no identity
+// provider to talk to.
+type Static struct {
+	token      string
+	externalID string
+}
+
+func NewStatic() (*Static, error) {
+	token := viper.GetString(config.Authenticator_Static_Token)
+	if token == "" {
+		return nil, ErrMissingStaticToken
+	}
+
+	externalID := viper.GetString(config.Authenticator_Static_External_ID)
+	if _, err := uuid.Parse(externalID); err != nil {
+		return nil, fmt.Errorf("failed to parse the static external ID: %w", err)
+	}
+
+	return &Static{
+		token:      token,
+		externalID: externalID,
+	}, nil
+}
+
+func (s *Static) Middleware() func(next http.Handler) http.Handler {
+	middleware := jwtmiddleware.New(
+		s.validate,
+		jwtmiddleware.WithErrorHandler(errorHandler),
+	)
+
+	return func(next http.Handler) http.Handler {
+		return middleware.CheckJWT(next)
+	}
+}
+
+func (s *Static) ExternalID(claims *CustomClaims) (string, error) {
+	return claims.ExternalID, nil
+}
+
+func (s *Static) validate(_ context.Context, candidate string) (interface{}, error) {
+	if subtle.ConstantTimeCompare([]byte(candidate), []byte(s.token)) != 1 {
+		return nil, ErrInvalidStaticToken
+	}
+
+	return &validator.ValidatedClaims{
+		CustomClaims: &CustomClaims{ // nolint:exhaustruct
+			Subject:    StaticSubject,
+			ExternalID: s.externalID,
+		},
+		RegisteredClaims: validator.RegisteredClaims{ // nolint:exhaustruct
+			Subject: StaticSubject,
+		},
+	}, nil
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/database"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the Postgres error code of a duplicate key
+const uniqueViolation = "23505"
+
+var (
+	ErrUserExists   = errors.New("user already exists")
+	ErrUserNotFound = errors.New("user not found")
+)
+
+// User is an account of the local authenticator
+type User struct {
+	ID           uuid.UUID `db:"id"`
+	Username     string    `db:"username"`
+	PasswordHash []byte    `db:"password_hash"`
+	ExternalID   uuid.UUID `db:"external_id"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// UserStore keeps the accounts of the local authenticator
+type UserStore interface {
+	Create(user *User) error
+	Find(username string) (*User, error)
+}
+
+// PostgresUserStore keeps the accounts in the users table
+type PostgresUserStore struct {
+	db *database.Conn
+}
+
+func NewUserStore(db *database.Conn) *PostgresUserStore {
+	return &PostgresUserStore{
+		db: db,
+	}
+}
+
+func (s *PostgresUserStore) Create(user *User) error {
+	if _, err := s.db.Exec("INSERT INTO users (id, username, password_hash, external_id) VALUES ($1, $2, $3, $4)",
+		user.ID,
+		user.Username,
+		user.PasswordHash,
+		user.ExternalID,
+	); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return ErrUserExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *PostgresUserStore) Find(username string) (*User, error) {
+	user := &User{} // nolint:exhaustruct
+
+	err := s.db.Get(user, "SELECT id, username, password_hash, external_id, created_at FROM users WHERE username = $1", username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
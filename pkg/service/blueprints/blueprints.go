@@ -1,8 +1,14 @@
 package blueprints
 
+import "errors"
+
+// ErrNotFound is wrapped by the errors the blueprint stores return for blueprints they don't have
+var ErrNotFound = errors.New("blueprint not found")
+
 const (
 	KindBuilding string = "building"
 	KindResource string = "resource"
+	KindResearch string = "research"
 )
 
 type Blueprint interface {
@@ -11,8 +17,10 @@ type Blueprint interface {
 	Kind() string
 	GetID() string
 	GetVersion() int
+	SetVersion(version int)
 	GetName() string
 }
 
 var _ Blueprint = (*Building)(nil)
 var _ Blueprint = (*Resource)(nil)
+var _ Blueprint = (*Research)(nil)
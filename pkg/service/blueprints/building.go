@@ -26,14 +26,33 @@ type Building struct {
 	Name           BuildingName         `json:"name" yaml:"name"`
 	InitialAmount  int                  `json:"initial_amount" yaml:"initial_amount"`
 	WorkersMaximum int                  `json:"workers_maximum" yaml:"workers_maximum"`
+	BuildSlots     int                  `json:"build_slots" yaml:"build_slots"`
 	Cost           []ResourceCost       `json:"cost" yaml:"cost"`
 	Generates      []Generator          `json:"generates" yaml:"generates"`
 	Transforms     []Transformer        `json:"transforms" yaml:"transforms"`
 	Stores         map[ResourceName]int `json:"stores" yaml:"stores"`
 	BuildTime      string               `json:"build_time" yaml:"build_time"`
+	CancelRefund   *int                 `json:"cancel_refund,omitempty" yaml:"cancel_refund,omitempty"`
+	Salvage        int                  `json:"salvage" yaml:"salvage"`
+	Levels         []Level              `json:"levels,omitempty" yaml:"levels,omitempty"`
+	Requires       Prerequisites        `json:"requires" yaml:"requires"`
 	Version        int                  `json:"version" yaml:"version"`
 }
 
+// Level describes an upgrade of a building. The base blueprint is level 1, the first
+// entry of levels is level 2 and so on. Fields left empty are inherited from the previous level.
+type Level struct {
+	Cost       []ResourceCost       `json:"cost,omitempty" yaml:"cost,omitempty"`
+	BuildTime  string               `json:"build_time,omitempty" yaml:"build_time,omitempty"`
+	Generates  []Generator          `json:"generates,omitempty" yaml:"generates,omitempty"`
+	Transforms []Transformer        `json:"transforms,omitempty" yaml:"transforms,omitempty"`
+	Stores     map[ResourceName]int `json:"stores,omitempty" yaml:"stores,omitempty"`
+}
+
+// DefaultCancelRefund is the percentage of the permanent cost returned when
+// a construction is cancelled and the blueprint doesn't define cancel_refund
+const DefaultCancelRefund = 100
+
 type Generator struct {
 	Name       ResourceName `json:"name" yaml:"name"`
 	Amount     int          `json:"amount" yaml:"amount"`
@@ -56,6 +75,7 @@ type Transformer struct {
 	Cost       []TransformerCost   `json:"cost" yaml:"cost"`
 	Result     []TransformerResult `json:"result" yaml:"result"`
 	TickLength string              `json:"tick_length" yaml:"tick_length"`
+	Requires   []ResearchName      `json:"requires,omitempty" yaml:"requires,omitempty"`
 }
 
 func (t Transformer) CostStructList() *structpb.ListValue {
@@ -97,6 +117,51 @@ func (t Transformer) ResultStructList() *structpb.ListValue {
 	return &list
 }
 
+// GetCancelRefund returns the percentage of the permanent cost refunded on cancellation
+func (b *Building) GetCancelRefund() int {
+	if b.CancelRefund == nil {
+		return DefaultCancelRefund
+	}
+
+	return *b.CancelRefund
+}
+
+// MaxLevel returns the highest level the building can be upgraded to
+func (b *Building) MaxLevel() int {
+	return len(b.Levels) + 1
+}
+
+// AtLevel returns a copy of the blueprint with the overrides of every level up to the given one applied
+func (b *Building) AtLevel(level int) *Building {
+	leveled := *b
+
+	for i := 0; i < level-1 && i < len(b.Levels); i++ {
+		override := b.Levels[i]
+
+		if len(override.Cost) > 0 {
+			leveled.Cost = override.Cost
+		}
+
+		if override.BuildTime != "" {
+			leveled.BuildTime = override.BuildTime
+		}
+
+		if len(override.Generates) > 0 {
+			leveled.Generates = override.Generates
+		}
+
+		if len(override.Transforms) > 0 {
+			leveled.Transforms = override.Transforms
+		}
+
+		if len(override.Stores) > 0 {
+			leveled.Stores = override.Stores
+		}
+	}
+
+	return &leveled
+}
+
 func (b *Building) Encode() ([]byte, error) {
 	buf := bytes.NewBuffer([]byte(""))
 	encoder := json.NewEncoder(buf)
@@ -108,7 +173,15 @@ func (b *Building) Encode() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Decode replaces the blueprint with the one Encode encoded
 func (b *Building) Decode(src []byte) error {
+	decoded := Building{} // nolint:exhaustruct
+	if err := json.Unmarshal(src, &decoded); err != nil {
+		return err
+	}
+
+	*b = decoded
+
 	return nil
 }
 
@@ -124,6 +197,10 @@ func (b *Building) GetVersion() int {
 	return b.Version
 }
 
+func (b *Building) SetVersion(version int) {
+	b.Version = version
+}
+
 func (b *Building) GetName() string {
 	return b.Name.String()
 }
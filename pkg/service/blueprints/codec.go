@@ -0,0 +1,141 @@
+package blueprints
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrUnknownKind = errors.New("unknown blueprint kind")
+
+// kinds creates an empty blueprint of every kind, new kinds only have to be added here to be read
+// from blueprint files and the remote registries
+var kinds = map[string]func() Blueprint{
+	KindBuilding: func() Blueprint { return &Building{} }, // nolint:exhaustruct
+	KindResource: func() Blueprint { return &Resource{} }, // nolint:exhaustruct
+	KindResearch: func() Blueprint { return &Research{} }, // nolint:exhaustruct
+}
+
+// New returns an empty blueprint of a kind. Kinds are matched case insensitively, blueprint files
+// name them Building, Resource and Research.
+func New(kind string) (Blueprint, error) {
+	fn, ok := kinds[strings.ToLower(kind)]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKind, kind)
+	}
+
+	return fn(), nil
+}
+
+// Decode returns the blueprint of a kind that Encode encoded
+func Decode(kind string, src []byte) (Blueprint, error) {
+	bp, err := New(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bp.Decode(src); err != nil {
+		return nil, err
+	}
+
+	return bp, nil
+}
+
+// DecodeNode decodes a YAML document into the kind of blueprint named by its kind field
+func DecodeNode(node *yaml.Node) (Blueprint, error) {
+	var header struct {
+		Kind string `yaml:"kind"`
+	}
+
+	if err := node.Decode(&header); err != nil {
+		return nil, err
+	}
+
+	bp, err := New(header.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := node.Decode(bp); err != nil {
+		return nil, err
+	}
+
+	return bp, nil
+}
+
+// EncodeNode encodes a blueprint into a YAML document, with a kind field DecodeNode can read back
+func EncodeNode(bp Blueprint) (*yaml.Node, error) {
+	node := &yaml.Node{} // nolint:exhaustruct
+	if err := node.Encode(bp); err != nil {
+		return nil, err
+	}
+
+	kind := bp.Kind()
+	if kind != "" {
+		kind = strings.ToUpper(kind[:1]) + kind[1:]
+	}
+
+	node.Content = append([]*yaml.Node{
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: "kind"}, // nolint:exhaustruct
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: kind},   // nolint:exhaustruct
+	}, node.Content...)
+
+	return node, nil
+}
+
+// NodePosition points at the first line of a document, after the document marker
+func NodePosition(file string, document int, node *yaml.Node) Position {
+	line := node.Line
+	if len(node.Content) > 0 {
+		line = node.Content[0].Line
+	}
+
+	return Position{
+		File:     file,
+		Document: document,
+		Line:     line,
+	}
+}
+
+// Decoder reads blueprints of any kind from a stream of YAML documents
+type Decoder struct {
+	decoder   *yaml.Decoder
+	file      string
+	documents int
+}
+
+// NewDecoder returns a decoder reading from r. The name of the file is used in the positions of
+// the documents and in errors.
+func NewDecoder(r io.Reader, file string) *Decoder {
+	return &Decoder{
+		decoder:   yaml.NewDecoder(r),
+		file:      file,
+		documents: 0,
+	}
+}
+
+// Decode reads the next blueprint of the stream along with its position, or returns io.EOF at the
+// end of the stream
+func (d *Decoder) Decode() (Document, error) {
+	var node yaml.Node
+	if err := d.decoder.Decode(&node); errors.Is(err, io.EOF) {
+		return Document{}, io.EOF // nolint:exhaustruct
+	} else if err != nil {
+		return Document{}, fmt.Errorf("%s: %w", d.file, err) // nolint:exhaustruct
+	}
+
+	d.documents++
+
+	bp, err := DecodeNode(&node)
+	if err != nil {
+		return Document{}, fmt.Errorf("%s (document %d): %w", d.file, d.documents, err) // nolint:exhaustruct
+	}
+
+	return Document{
+		Position:  NodePosition(d.file, d.documents, &node),
+		Blueprint: bp,
+	}, nil
+}
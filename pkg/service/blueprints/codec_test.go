@@ -0,0 +1,128 @@
+package blueprints
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func testBlueprints() []Blueprint {
+	refund := 50
+
+	return []Blueprint{
+		&Building{ // nolint:exhaustruct
+			ID:             uuid.New(),
+			Name:           "Sawmill",
+			WorkersMaximum: 2,
+			BuildTime:      "10s",
+			CancelRefund:   &refund,
+			Cost:           []ResourceCost{{Resource: "Wood", Amount: 20, Permanent: true}},
+			Generates:      []Generator{{Name: "Planks", Amount: 1, TickLength: "5s"}},
+			Stores:         map[ResourceName]int{"Planks": 50},
+			Levels:         []Level{{BuildTime: "20s"}},                                // nolint:exhaustruct
+			Requires:       Prerequisites{Buildings: map[BuildingName]int{"House": 1}}, // nolint:exhaustruct
+			Version:        3,
+		},
+		&Resource{Name: "Wood", StartingAmount: 100, CapFormula: "return 100", Version: 2},
+		&Research{ // nolint:exhaustruct
+			ID:           uuid.New(),
+			Name:         "Masonry",
+			ResearchTime: "1m",
+			Cost:         []ResourceCost{{Resource: "Wood", Amount: 10, Permanent: true}},
+			Version:      1,
+		},
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, bp := range testBlueprints() {
+		encoded, err := bp.Encode()
+		assert.NoError(t, err)
+
+		decoded, err := Decode(bp.Kind(), encoded)
+		assert.NoError(t, err)
+		assert.Equal(t, bp, decoded)
+	}
+
+	// Decoding replaces every field of the blueprint
+	stale := &Resource{Name: "Stone", CapFormula: "return 10", Version: 4} // nolint:exhaustruct
+	assert.NoError(t, stale.Decode([]byte(`{"name": "Wood"}`)))
+	assert.Equal(t, &Resource{Name: "Wood"}, stale) // nolint:exhaustruct
+
+	_, err := Decode("quarry", []byte(`{}`))
+	assert.True(t, errors.Is(err, ErrUnknownKind))
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	bps := testBlueprints()
+
+	buf := bytes.NewBufferString("")
+	encoder := yaml.NewEncoder(buf)
+
+	for _, bp := range bps {
+		node, err := EncodeNode(bp)
+		assert.NoError(t, err)
+		assert.NoError(t, encoder.Encode(node))
+	}
+
+	assert.NoError(t, encoder.Close())
+
+	decoder := NewDecoder(buf, "mixed.yaml")
+	decoded := make([]Blueprint, 0)
+
+	for {
+		doc, err := decoder.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, len(decoded)+1, doc.Position.Document)
+
+		decoded = append(decoded, doc.Blueprint)
+	}
+
+	if assert.Len(t, decoded, 3) {
+		for i, bp := range bps {
+			assert.Equal(t, bp.Kind(), decoded[i].Kind())
+			assert.True(t, Equal(bp, decoded[i]), bp.GetName())
+		}
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	stream := `kind: Building
+name: House
+build_time: 10s
+---
+kind: resource
+name: Wood
+---
+kind: Quarry
+name: Stone
+`
+
+	decoder := NewDecoder(strings.NewReader(stream), "mixed.yaml")
+
+	doc, err := decoder.Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, &Building{Name: "House", BuildTime: "10s"}, doc.Blueprint) // nolint:exhaustruct
+	assert.Equal(t, "mixed.yaml:1 (document 1)", doc.Position.String())
+
+	doc, err = decoder.Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, &Resource{Name: "Wood"}, doc.Blueprint) // nolint:exhaustruct
+	assert.Equal(t, 5, doc.Position.Line)
+
+	_, err = decoder.Decode()
+	assert.EqualError(t, err, `mixed.yaml (document 3): unknown blueprint kind "Quarry"`)
+
+	_, err = decoder.Decode()
+	assert.Equal(t, io.EOF, err)
+}
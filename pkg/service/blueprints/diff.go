@@ -0,0 +1,146 @@
+package blueprints
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// FieldChange is a field that differs between two blueprints. Old or New is nil if the field is
+// missing from that blueprint.
+type FieldChange struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+func (fc FieldChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", fc.Field, formatValue(fc.Old), formatValue(fc.New))
+}
+
+// Diff lists the fields that differ between two blueprints of the same kind, in the order of their
+// encoding. IDs and versions are left out, they are assigned by the registry.
+func Diff(a, b Blueprint) ([]FieldChange, error) {
+	fieldsA, err := fields(a)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldsB, err := fields(b)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]FieldChange, 0)
+	diffValues(&changes, "", fieldsA, fieldsB)
+
+	return changes, nil
+}
+
+func fields(bp Blueprint) (map[string]interface{}, error) {
+	encoded, err := bp.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(encoded, &m); err != nil {
+		return nil, err
+	}
+
+	delete(m, "id")
+	delete(m, "version")
+
+	return m, nil
+}
+
+func diffValues(changes *[]FieldChange, field string, a, b interface{}) {
+	if isEmpty(a) && isEmpty(b) {
+		return
+	}
+
+	mapA, okA := a.(map[string]interface{})
+	mapB, okB := b.(map[string]interface{})
+
+	if okA && okB {
+		keys := make([]string, 0, len(mapA)+len(mapB))
+		for key := range mapA {
+			keys = append(keys, key)
+		}
+
+		for key := range mapB {
+			if _, ok := mapA[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			diffValues(changes, join(field, key), mapA[key], mapB[key])
+		}
+
+		return
+	}
+
+	sliceA, okA := a.([]interface{})
+	sliceB, okB := b.([]interface{})
+
+	if okA && okB {
+		for i := 0; i < len(sliceA) || i < len(sliceB); i++ {
+			var itemA, itemB interface{}
+
+			if i < len(sliceA) {
+				itemA = sliceA[i]
+			}
+
+			if i < len(sliceB) {
+				itemB = sliceB[i]
+			}
+
+			diffValues(changes, fmt.Sprintf("%s[%d]", field, i), itemA, itemB)
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		*changes = append(*changes, FieldChange{Field: field, Old: a, New: b})
+	}
+}
+
+// isEmpty treats missing fields, nulls and empty lists or maps alike
+func isEmpty(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	}
+
+	return false
+}
+
+func join(field, key string) string {
+	if field == "" {
+		return key
+	}
+
+	return field + "." + key
+}
+
+func formatValue(v interface{}) string {
+	if v == nil {
+		return "(none)"
+	}
+
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(encoded)
+}
@@ -0,0 +1,46 @@
+package blueprints
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	before := &Building{ // nolint:exhaustruct
+		Name:      "Sawmill",
+		BuildTime: "10s",
+		Cost:      []ResourceCost{{Resource: "Wood", Amount: 20, Permanent: true}},
+		Version:   3,
+	}
+
+	after := &Building{ // nolint:exhaustruct
+		Name:      "Sawmill",
+		BuildTime: "20s",
+		Cost: []ResourceCost{
+			{Resource: "Wood", Amount: 30, Permanent: true},
+			{Resource: "Stone", Amount: 5, Permanent: true},
+		},
+		Stores:  map[ResourceName]int{},
+		Version: 1,
+	}
+
+	changes, err := Diff(before, after)
+	assert.NoError(t, err)
+
+	lines := make([]string, 0, len(changes))
+	for _, change := range changes {
+		lines = append(lines, change.String())
+	}
+
+	assert.Equal(t, []string{
+		`build_time: "10s" -> "20s"`,
+		`cost[0].Amount: 20 -> 30`,
+		`cost[1]: (none) -> {"Amount":5,"Permanent":true,"Resource":"Stone"}`,
+	}, lines)
+
+	// Versions aren't part of the blueprint as far as the diff is concerned
+	changes, err = Diff(before, &Building{Name: "Sawmill", BuildTime: "10s", Cost: before.Cost}) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,73 @@
+package blueprints
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type ResearchName string
+
+func (r ResearchName) String() string {
+	return string(r)
+}
+
+type Research struct {
+	ID           uuid.UUID            `json:"id" yaml:"id"`
+	Name         ResearchName         `json:"name" yaml:"name"`
+	Cost         []ResourceCost       `json:"cost" yaml:"cost"`
+	ResearchTime string               `json:"research_time" yaml:"research_time"`
+	Requires     Prerequisites        `json:"requires" yaml:"requires"`
+	CapBonus     map[ResourceName]int `json:"cap_bonus,omitempty" yaml:"cap_bonus,omitempty"`
+	Version      int                  `json:"version" yaml:"version"`
+}
+
+// Prerequisites lists what an inventory needs before a blueprint becomes available
+type Prerequisites struct {
+	Buildings map[BuildingName]int `json:"buildings,omitempty" yaml:"buildings,omitempty"`
+	Research  []ResearchName       `json:"research,omitempty" yaml:"research,omitempty"`
+}
+
+func (r *Research) Encode() ([]byte, error) {
+	buf := bytes.NewBuffer([]byte(""))
+	encoder := json.NewEncoder(buf)
+
+	if err := encoder.Encode(*r); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Decode replaces the blueprint with the one Encode encoded
+func (r *Research) Decode(src []byte) error {
+	decoded := Research{} // nolint:exhaustruct
+	if err := json.Unmarshal(src, &decoded); err != nil {
+		return err
+	}
+
+	*r = decoded
+
+	return nil
+}
+
+func (r *Research) Kind() string {
+	return KindResearch
+}
+
+func (r *Research) GetID() string {
+	return r.ID.String()
+}
+
+func (r *Research) GetVersion() int {
+	return r.Version
+}
+
+func (r *Research) SetVersion(version int) {
+	r.Version = version
+}
+
+func (r *Research) GetName() string {
+	return r.Name.String()
+}
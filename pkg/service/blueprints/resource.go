@@ -36,7 +36,15 @@ func (r *Resource) Encode() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Decode replaces the blueprint with the one Encode encoded
 func (r *Resource) Decode(src []byte) error {
+	decoded := Resource{} // nolint:exhaustruct
+	if err := json.Unmarshal(src, &decoded); err != nil {
+		return err
+	}
+
+	*r = decoded
+
 	return nil
 }
 
@@ -52,6 +60,10 @@ func (r *Resource) GetVersion() int {
 	return r.Version
 }
 
+func (r *Resource) SetVersion(version int) {
+	r.Version = version
+}
+
 func (r *Resource) GetName() string {
 	return r.Name.String()
 }
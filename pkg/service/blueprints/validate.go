@@ -0,0 +1,336 @@
+package blueprints
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
+)
+
+// Position locates a blueprint in the file it was read from. Documents are counted from 1.
+type Position struct {
+	File     string
+	Document int
+	Line     int
+}
+
+func (p Position) String() string {
+	if p.File == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d (document %d)", p.File, p.Line, p.Document)
+}
+
+// Document is a blueprint along with where it was read from
+type Document struct {
+	Position  Position
+	Blueprint Blueprint
+}
+
+// Problem is something wrong with a field of a blueprint
+type Problem struct {
+	Position Position
+	Kind     string
+	Name     string
+	Field    string
+	Message  string
+}
+
+func (p Problem) String() string {
+	prefix := ""
+	if pos := p.Position.String(); pos != "" {
+		prefix = pos + ": "
+	}
+
+	if p.Field == "" {
+		return fmt.Sprintf("%s%s %q: %s", prefix, p.Kind, p.Name, p.Message)
+	}
+
+	return fmt.Sprintf("%s%s %q: %s: %s", prefix, p.Kind, p.Name, p.Field, p.Message)
+}
+
+// ValidationError lists every problem found in a set of blueprints
+type ValidationError struct {
+	Problems []Problem
+}
+
+func (ve *ValidationError) Error() string {
+	lines := make([]string, 0, len(ve.Problems))
+	for _, p := range ve.Problems {
+		lines = append(lines, p.String())
+	}
+
+	return fmt.Sprintf("%d blueprint problem(s):\n%s", len(ve.Problems), strings.Join(lines, "\n"))
+}
+
+// CapFunction wraps a cap formula into the Lua function the inventories call with the building
+// and resource tables
+func CapFunction(formula string) string {
+	return fmt.Sprintf(`
+function derive(buildings, resources)
+	%s
+end
+`, formula)
+}
+
+// Check validates a single blueprint on its own: names, durations, percentages and formulas.
+// References to other blueprints can only be checked along with them, see Validate.
+func Check(bp Blueprint) error {
+	c := &checker{}                  // nolint:exhaustruct
+	c.check(Document{Blueprint: bp}) // nolint:exhaustruct
+
+	return c.err()
+}
+
+// Validate checks a set of blueprints like Check, and that every building, resource and research
+// they reference is part of the set and that no name is used twice
+func Validate(docs []Document) error {
+	c := &checker{ // nolint:exhaustruct
+		refs: map[string]map[string]Position{
+			KindBuilding: make(map[string]Position),
+			KindResource: make(map[string]Position),
+			KindResearch: make(map[string]Position),
+		},
+	}
+
+	for _, doc := range docs {
+		names := c.refs[doc.Blueprint.Kind()]
+		if names == nil {
+			continue
+		}
+
+		if first, ok := names[doc.Blueprint.GetName()]; ok {
+			c.position = doc.Position
+			c.kind, c.name = doc.Blueprint.Kind(), doc.Blueprint.GetName()
+			c.addf("", "duplicate name, first defined at %s", first)
+
+			continue
+		}
+
+		names[doc.Blueprint.GetName()] = doc.Position
+	}
+
+	for _, doc := range docs {
+		c.check(doc)
+	}
+
+	return c.err()
+}
+
+type checker struct {
+	// refs holds the names of the blueprints by kind, nil if references aren't checked
+	refs     map[string]map[string]Position
+	problems []Problem
+
+	position Position
+	kind     string
+	name     string
+}
+
+func (c *checker) err() error {
+	if len(c.problems) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Problems: c.problems}
+}
+
+func (c *checker) addf(field, format string, args ...interface{}) {
+	c.problems = append(c.problems, Problem{
+		Position: c.position,
+		Kind:     c.kind,
+		Name:     c.name,
+		Field:    field,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *checker) check(doc Document) {
+	c.position = doc.Position
+	c.kind, c.name = doc.Blueprint.Kind(), doc.Blueprint.GetName()
+
+	if c.name == "" {
+		c.addf("name", "can't be empty")
+	}
+
+	switch bp := doc.Blueprint.(type) {
+	case *Building:
+		c.building(bp)
+	case *Resource:
+		c.resource(bp)
+	case *Research:
+		c.research(bp)
+	}
+}
+
+func (c *checker) building(b *Building) {
+	c.duration("build_time", b.BuildTime, true)
+	c.costs("cost", b.Cost)
+	c.generators("generates", b.Generates)
+	c.transformers("transforms", b.Transforms)
+	c.stores("stores", b.Stores)
+	c.prerequisites("requires", b.Requires)
+	c.percentage("cancel_refund", b.GetCancelRefund())
+	c.percentage("salvage", b.Salvage)
+
+	for i, level := range b.Levels {
+		field := fmt.Sprintf("levels[%d]", i)
+
+		c.duration(field+".build_time", level.BuildTime, false)
+		c.costs(field+".cost", level.Cost)
+		c.generators(field+".generates", level.Generates)
+		c.transformers(field+".transforms", level.Transforms)
+		c.stores(field+".stores", level.Stores)
+	}
+}
+
+func (c *checker) resource(r *Resource) {
+	if r.StartingAmount < 0 {
+		c.addf("starting_amount", "can't be negative")
+	}
+
+	if r.CapFormula == "" {
+		return
+	}
+
+	chunk, err := parse.Parse(strings.NewReader(CapFunction(r.CapFormula)), r.Name.String())
+	if err != nil {
+		c.addf("cap_formula", "%s", err)
+		return
+	}
+
+	if _, err := lua.Compile(chunk, r.Name.String()); err != nil {
+		c.addf("cap_formula", "%s", err)
+	}
+}
+
+func (c *checker) research(r *Research) {
+	c.duration("research_time", r.ResearchTime, true)
+	c.costs("cost", r.Cost)
+	c.prerequisites("requires", r.Requires)
+
+	for _, name := range sortedKeys(r.CapBonus) {
+		c.reference(fmt.Sprintf("cap_bonus.%s", name), KindResource, name.String())
+	}
+}
+
+func (c *checker) duration(field, value string, required bool) {
+	if value == "" {
+		if required {
+			c.addf(field, "can't be empty")
+		}
+
+		return
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		c.addf(field, "%s", err)
+		return
+	}
+
+	if d <= 0 {
+		c.addf(field, "has to be positive, got %s", value)
+	}
+}
+
+func (c *checker) percentage(field string, value int) {
+	if value < 0 || value > 100 {
+		c.addf(field, "has to be between 0 and 100, got %d", value)
+	}
+}
+
+func (c *checker) amount(field string, value int) {
+	if value <= 0 {
+		c.addf(field, "has to be positive, got %d", value)
+	}
+}
+
+func (c *checker) costs(field string, costs []ResourceCost) {
+	for i, cost := range costs {
+		c.reference(fmt.Sprintf("%s[%d].resource", field, i), KindResource, cost.Resource.String())
+		c.amount(fmt.Sprintf("%s[%d].amount", field, i), cost.Amount)
+	}
+}
+
+func (c *checker) generators(field string, generators []Generator) {
+	for i, g := range generators {
+		c.reference(fmt.Sprintf("%s[%d].name", field, i), KindResource, g.Name.String())
+		c.amount(fmt.Sprintf("%s[%d].amount", field, i), g.Amount)
+		c.duration(fmt.Sprintf("%s[%d].tick_length", field, i), g.TickLength, true)
+	}
+}
+
+func (c *checker) transformers(field string, transformers []Transformer) {
+	for i, t := range transformers {
+		prefix := fmt.Sprintf("%s[%d]", field, i)
+
+		c.duration(prefix+".tick_length", t.TickLength, true)
+
+		for j, cost := range t.Cost {
+			c.reference(fmt.Sprintf("%s.cost[%d].resource", prefix, j), KindResource, cost.Resource.String())
+			c.amount(fmt.Sprintf("%s.cost[%d].amount", prefix, j), cost.Amount)
+		}
+
+		if len(t.Result) == 0 {
+			c.addf(prefix+".result", "can't be empty")
+		}
+
+		for j, result := range t.Result {
+			c.reference(fmt.Sprintf("%s.result[%d].resource", prefix, j), KindResource, result.Resource.String())
+			c.amount(fmt.Sprintf("%s.result[%d].amount", prefix, j), result.Amount)
+		}
+
+		for j, research := range t.Requires {
+			c.reference(fmt.Sprintf("%s.requires[%d]", prefix, j), KindResearch, research.String())
+		}
+	}
+}
+
+func (c *checker) stores(field string, stores map[ResourceName]int) {
+	for _, name := range sortedKeys(stores) {
+		c.reference(fmt.Sprintf("%s.%s", field, name), KindResource, name.String())
+	}
+}
+
+func (c *checker) prerequisites(field string, p Prerequisites) {
+	for _, name := range sortedKeys(p.Buildings) {
+		c.reference(fmt.Sprintf("%s.buildings.%s", field, name), KindBuilding, name.String())
+		c.amount(fmt.Sprintf("%s.buildings.%s", field, name), p.Buildings[name])
+	}
+
+	for i, name := range p.Research {
+		c.reference(fmt.Sprintf("%s.research[%d]", field, i), KindResearch, name.String())
+	}
+}
+
+func (c *checker) reference(field, kind, name string) {
+	if name == "" {
+		c.addf(field, "can't be empty")
+		return
+	}
+
+	if c.refs == nil {
+		return
+	}
+
+	if _, ok := c.refs[kind][name]; !ok {
+		c.addf(field, "unknown %s %q", kind, name)
+	}
+}
+
+// sortedKeys keeps the problems of map fields in a stable order
+func sortedKeys[K ~string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
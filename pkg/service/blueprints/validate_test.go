@@ -0,0 +1,83 @@
+package blueprints
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func problems(t *testing.T, err error) []string {
+	t.Helper()
+
+	if err == nil {
+		return nil
+	}
+
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+
+	res := make([]string, 0, len(validationError.Problems))
+	for _, p := range validationError.Problems {
+		res = append(res, p.String())
+	}
+
+	return res
+}
+
+func TestCheck(t *testing.T) {
+	refund := 150
+
+	err := Check(&Building{ // nolint:exhaustruct
+		Name:         "Sawmill",
+		BuildTime:    "-1s",
+		CancelRefund: &refund,
+		Cost:         []ResourceCost{{Resource: "Unknown", Amount: 0, Permanent: true}},
+		Levels: []Level{
+			{BuildTime: "later"}, // nolint:exhaustruct
+		},
+	})
+
+	// References aren't checked on their own
+	assert.Equal(t, []string{
+		`building "Sawmill": build_time: has to be positive, got -1s`,
+		`building "Sawmill": cost[0].amount: has to be positive, got 0`,
+		`building "Sawmill": cancel_refund: has to be between 0 and 100, got 150`,
+		`building "Sawmill": levels[0].build_time: time: invalid duration "later"`,
+	}, problems(t, err))
+
+	assert.NoError(t, Check(&Resource{Name: "Wood", CapFormula: "return 100+buildings.warehouse*100"})) // nolint:exhaustruct
+	assert.Len(t, problems(t, Check(&Resource{Name: "Wood", CapFormula: "return 100+"})), 1)            // nolint:exhaustruct
+}
+
+func TestValidateReferences(t *testing.T) {
+	docs := []Document{
+		{Blueprint: &Resource{Name: "Wood"}}, // nolint:exhaustruct
+		{Blueprint: &Research{ // nolint:exhaustruct
+			Name:         "Carpentry",
+			ResearchTime: "30s",
+			Requires:     Prerequisites{Buildings: map[BuildingName]int{"Workshop": 1}}, // nolint:exhaustruct
+			CapBonus:     map[ResourceName]int{"Planks": 50},
+		}},
+		{Blueprint: &Building{ // nolint:exhaustruct
+			Name:      "Lumberyard",
+			BuildTime: "10s",
+			Transforms: []Transformer{{
+				Name:       "Planks",
+				Cost:       []TransformerCost{{Resource: "Wood", Amount: 5}}, // nolint:exhaustruct
+				Result:     []TransformerResult{{Resource: "Planks", Amount: 1}},
+				TickLength: "10s",
+				Requires:   []ResearchName{"Carpentry", "Sawing"},
+			}},
+		}},
+	}
+
+	assert.Equal(t, []string{
+		`research "Carpentry": requires.buildings.Workshop: unknown building "Workshop"`,
+		`research "Carpentry": cap_bonus.Planks: unknown resource "Planks"`,
+		`building "Lumberyard": transforms[0].result[0].resource: unknown resource "Planks"`,
+		`building "Lumberyard": transforms[0].requires[1]: unknown research "Sawing"`,
+	}, problems(t, Validate(docs)))
+}
@@ -0,0 +1,50 @@
+package blueprints
+
+// Revise sets the version of a blueprint replacing a previous one, and reports whether it changed.
+// Unchanged blueprints keep the previous version, changed ones get the next version unless they
+// were given a higher one already. Blueprints without a previous one start at version 1.
+func Revise(previous, next Blueprint) bool {
+	version := next.GetVersion()
+
+	if previous == nil {
+		if version < 1 {
+			next.SetVersion(1)
+		}
+
+		return true
+	}
+
+	next.SetVersion(previous.GetVersion())
+	if Equal(previous, next) {
+		return false
+	}
+
+	if version <= previous.GetVersion() {
+		version = previous.GetVersion() + 1
+	}
+
+	next.SetVersion(version)
+
+	return true
+}
+
+// Equal reports whether two blueprints have the same ID, version and fields. Empty lists and maps
+// are the same as missing ones, so a blueprint exported to YAML and read back is equal to itself.
+func Equal(a, b Blueprint) bool {
+	if a.GetID() != b.GetID() || a.GetVersion() != b.GetVersion() {
+		return false
+	}
+
+	changes, err := Diff(a, b)
+
+	return err == nil && len(changes) == 0
+}
+
+// Change is a blueprint put into or deleted from a registry. Blueprint is nil for deletions.
+type Change struct {
+	Kind      string
+	Name      string
+	Blueprint Blueprint
+	// Revision is the revision of the registry the change was made at, if it has one
+	Revision int64
+}
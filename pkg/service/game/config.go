@@ -1,6 +0,0 @@
-package game
-
-var (
-	queueBuildings         bool  = false
-	maximumBuildingRequest int64 = 3
-)
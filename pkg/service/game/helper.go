@@ -1,16 +1,20 @@
 package game
 
-import "github.com/0xa1-red/empires-of-avalon/pkg/model"
+import (
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/pkg/model"
+	"github.com/spf13/viper"
+)
 
 func GetBuildingAmount(r model.BuildRequest) int64 {
-	if !queueBuildings {
+	amt := int64(r.Amount)
+
+	if amt < 1 {
 		return 1
 	}
 
-	amt := int64(r.Amount)
-
-	if amt > maximumBuildingRequest {
-		return maximumBuildingRequest
+	if maximum := viper.GetInt64(config.Inventory_Max_Build_Amount); maximum > 0 && amt > maximum {
+		return maximum
 	}
 
 	return amt
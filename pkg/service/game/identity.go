@@ -15,3 +15,8 @@ func GetInventoryID(userID uuid.UUID) uuid.UUID {
 	label := fmt.Sprintf("%s-inventory", userID.String())
 	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(label))
 }
+
+func GetResearchID(researchName string) uuid.UUID {
+	label := fmt.Sprintf("research-%s", strings.ToLower(researchName))
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(label))
+}
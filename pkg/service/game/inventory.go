@@ -29,3 +29,101 @@ func Describe(ctx context.Context, userID uuid.UUID) (*protobuf.DescribeInventor
 
 	return res, err
 }
+
+func CancelBuilding(ctx context.Context, userID, buildingID uuid.UUID) (*protobuf.CancelBuildingResponse, error) {
+	inventoryID := GetInventoryID(userID)
+
+	slog.Info("getting inventory grain client", "id", inventoryID.String())
+	inventory := protobuf.GetInventoryGrainClient(gamecluster.GetC(), inventoryID.String())
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, &carrier)
+
+	return inventory.CancelBuilding(&protobuf.CancelBuildingRequest{
+		TraceID:    carrier.Get("traceparent"),
+		BuildingID: buildingID.String(),
+		Timestamp:  timestamppb.Now(),
+	})
+}
+
+func Demolish(ctx context.Context, userID, buildingID uuid.UUID) (*protobuf.DemolishResponse, error) {
+	inventoryID := GetInventoryID(userID)
+
+	slog.Info("getting inventory grain client", "id", inventoryID.String())
+	inventory := protobuf.GetInventoryGrainClient(gamecluster.GetC(), inventoryID.String())
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, &carrier)
+
+	return inventory.Demolish(&protobuf.DemolishRequest{
+		TraceID:    carrier.Get("traceparent"),
+		BuildingID: buildingID.String(),
+		Timestamp:  timestamppb.Now(),
+	})
+}
+
+func AssignWorkers(ctx context.Context, userID, buildingID uuid.UUID, workers int) (*protobuf.AssignWorkersResponse, error) {
+	inventoryID := GetInventoryID(userID)
+
+	slog.Info("getting inventory grain client", "id", inventoryID.String())
+	inventory := protobuf.GetInventoryGrainClient(gamecluster.GetC(), inventoryID.String())
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, &carrier)
+
+	return inventory.AssignWorkers(&protobuf.AssignWorkersRequest{
+		TraceID:    carrier.Get("traceparent"),
+		BuildingID: buildingID.String(),
+		Workers:    int64(workers),
+		Timestamp:  timestamppb.Now(),
+	})
+}
+
+func SetBuildingActive(ctx context.Context, userID, buildingID uuid.UUID, active bool) (*protobuf.SetBuildingActiveResponse, error) {
+	inventoryID := GetInventoryID(userID)
+
+	slog.Info("getting inventory grain client", "id", inventoryID.String())
+	inventory := protobuf.GetInventoryGrainClient(gamecluster.GetC(), inventoryID.String())
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, &carrier)
+
+	return inventory.SetBuildingActive(&protobuf.SetBuildingActiveRequest{
+		TraceID:    carrier.Get("traceparent"),
+		BuildingID: buildingID.String(),
+		Active:     active,
+		Timestamp:  timestamppb.Now(),
+	})
+}
+
+func UpgradeBuilding(ctx context.Context, userID, buildingID uuid.UUID) (*protobuf.UpgradeBuildingResponse, error) {
+	inventoryID := GetInventoryID(userID)
+
+	slog.Info("getting inventory grain client", "id", inventoryID.String())
+	inventory := protobuf.GetInventoryGrainClient(gamecluster.GetC(), inventoryID.String())
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, &carrier)
+
+	return inventory.UpgradeBuilding(&protobuf.UpgradeBuildingRequest{
+		TraceID:    carrier.Get("traceparent"),
+		BuildingID: buildingID.String(),
+		Timestamp:  timestamppb.Now(),
+	})
+}
+
+func StartResearch(ctx context.Context, userID uuid.UUID, name string) (*protobuf.StartResearchResponse, error) {
+	inventoryID := GetInventoryID(userID)
+
+	slog.Info("getting inventory grain client", "id", inventoryID.String())
+	inventory := protobuf.GetInventoryGrainClient(gamecluster.GetC(), inventoryID.String())
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, &carrier)
+
+	return inventory.StartResearch(&protobuf.StartResearchRequest{
+		TraceID:   carrier.Get("traceparent"),
+		Name:      name,
+		Timestamp: timestamppb.Now(),
+	})
+}
@@ -2,6 +2,7 @@ package registry
 
 import (
 	"fmt"
+	"maps"
 	"sync"
 
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
@@ -25,7 +26,7 @@ func (s *BuildingStore) Put(item *blueprints.Building) {
 	s.mx.Lock()
 	defer s.mx.Unlock()
 
-	slog.Debug("registering building blueprint", "id", item.ID.String(), "name", item.Name.String())
+	slog.Debug("registering building blueprint", "id", item.ID.String(), "name", item.Name.String(), "version", item.Version)
 
 	s.store[item.Name] = item
 }
@@ -41,3 +42,27 @@ func (s *BuildingStore) Get(name blueprints.BuildingName) (*blueprints.Building,
 
 	return item, nil
 }
+
+// All returns a copy of the store, so it can be iterated while blueprints change
+func (s *BuildingStore) All() map[blueprints.BuildingName]*blueprints.Building {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return maps.Clone(s.store)
+}
+
+// Delete removes a blueprint from the store and reports whether it was there
+func (s *BuildingStore) Delete(name blueprints.BuildingName) bool {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if _, ok := s.store[name]; !ok {
+		return false
+	}
+
+	slog.Debug("removing building blueprint", "name", name.String())
+
+	delete(s.store, name)
+
+	return true
+}
@@ -0,0 +1,68 @@
+package registry
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"gopkg.in/yaml.v3"
+)
+
+// exportFiles are the files WriteDocuments writes the blueprints of each kind to
+var exportFiles = []struct {
+	kind string
+	file string
+}{
+	{blueprints.KindBuilding, "buildings.yaml"},
+	{blueprints.KindResource, "resources.yaml"},
+	{blueprints.KindResearch, "research.yaml"},
+}
+
+// WriteDocuments writes blueprints by kind and name into a blueprint directory, in the layout
+// ReadDocuments reads. Kinds without blueprints don't get a file.
+func WriteDocuments(path string, bps map[string]map[string]blueprints.Blueprint) error {
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return err
+	}
+
+	for _, export := range exportFiles {
+		items := bps[export.kind]
+		if len(items) == 0 {
+			continue
+		}
+
+		names := make([]string, 0, len(items))
+		for name := range items {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		buf := bytes.NewBufferString("")
+		encoder := yaml.NewEncoder(buf)
+		encoder.SetIndent(2)
+
+		for _, name := range names {
+			node, err := blueprints.EncodeNode(items[name])
+			if err != nil {
+				return err
+			}
+
+			if err := encoder.Encode(node); err != nil {
+				return err
+			}
+		}
+
+		if err := encoder.Close(); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(path, export.file), buf.Bytes(), 0o644); err != nil { // nolint:gosec
+			return err
+		}
+	}
+
+	return nil
+}
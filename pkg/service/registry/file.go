@@ -0,0 +1,112 @@
+package registry
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/fsnotify/fsnotify"
+	"golang.org/x/exp/slog"
+)
+
+// fileSettle is how long the directory has to be quiet before it's read again, editors tend to
+// write a file in several steps
+const fileSettle = 250 * time.Millisecond
+
+// watchDirectory keeps the registry up to date with the blueprint directory until the context is
+// done. Changes that don't validate are logged and the registry keeps the blueprints it has.
+func watchDirectory(ctx context.Context, path string) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		slog.Error("failed to watch the blueprint directory", err, "path", path)
+		return
+	}
+	defer watcher.Close() // nolint
+
+	if err := watcher.Add(path); err != nil {
+		slog.Error("failed to watch the blueprint directory", err, "path", path)
+		return
+	}
+
+	slog.Info("watching blueprint directory", "path", path)
+
+	var settled <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+
+			if !IsBlueprintFile(event.Name) || event.Op == fsnotify.Chmod {
+				continue
+			}
+
+			settled = time.After(fileSettle)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+
+			slog.Warn("blueprint directory watch error", "error", err, "path", path)
+		case <-settled:
+			settled = nil
+
+			if err := syncDirectory(path); err != nil {
+				slog.Error("failed to reload the blueprint directory", err, "path", path)
+			}
+		}
+	}
+}
+
+// syncDirectory makes the registry match the blueprint directory, and announces every change.
+// Blueprints that are gone from the directory are deleted from the registry.
+func syncDirectory(path string) error {
+	docs, err := ReadDocuments(path)
+	if err != nil {
+		return err
+	}
+
+	if err := blueprints.Validate(docs); err != nil {
+		return err
+	}
+
+	store, err := getStore()
+	if err != nil {
+		return err
+	}
+
+	found := map[string]map[string]bool{
+		blueprints.KindBuilding: make(map[string]bool),
+		blueprints.KindResource: make(map[string]bool),
+		blueprints.KindResearch: make(map[string]bool),
+	}
+
+	for _, doc := range docs {
+		bp := doc.Blueprint
+		found[bp.Kind()][bp.GetName()] = true
+
+		changed, err := push(bp)
+		if err != nil {
+			return err
+		}
+
+		if changed {
+			slog.Info("reloaded blueprint", "kind", bp.Kind(), "name", bp.GetName(), "version", bp.GetVersion())
+			announce(blueprints.Change{Kind: bp.Kind(), Name: bp.GetName(), Blueprint: bp, Revision: 0})
+		}
+	}
+
+	for kind, names := range store.names() {
+		for _, name := range names {
+			if !found[kind][name] {
+				apply(blueprints.Change{Kind: kind, Name: name, Blueprint: nil, Revision: 0})
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,10 +1,14 @@
 package registry
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 
 	"github.com/0xa1-red/empires-of-avalon/config"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
@@ -16,7 +20,7 @@ import (
 )
 
 type storeable interface {
-	*blueprints.Building | *blueprints.Resource
+	*blueprints.Building | *blueprints.Resource | *blueprints.Research
 }
 
 type InvalidFieldError struct {
@@ -33,11 +37,15 @@ func NewInvalidFieldError(field string) InvalidFieldError {
 	}
 }
 
-var registry *store
+var (
+	registry *store
+	revision atomic.Int64
+)
 
 type store struct {
 	buildings *BuildingStore
 	resources *ResourceStore
+	research  *ResearchStore
 }
 
 type DecoderWithError struct {
@@ -63,6 +71,109 @@ func (dwe *DecoderWithError) Decode(v any) error {
 }
 
 func ReadYaml[T storeable](path string) ([]T, error) {
+	collection, _, err := readFile[T](path)
+	return collection, err
+}
+
+// kindFiles hold every blueprint of a kind. Any other YAML file of a blueprint directory can hold
+// blueprints of any kind, told apart by their kind field.
+var kindFiles = map[string]bool{
+	"buildings.yaml": true,
+	"resources.yaml": true,
+	"research.yaml":  true,
+}
+
+// ReadDocuments reads every blueprint of a directory along with its position, for validation.
+// Each of buildings.yaml, resources.yaml and research.yaml is optional, blueprints can also be
+// kept in files of their own.
+func ReadDocuments(path string) ([]blueprints.Document, error) {
+	docs := make([]blueprints.Document, 0)
+
+	buildings, positions, err := readFile[*blueprints.Building](path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	for i, building := range buildings {
+		docs = append(docs, blueprints.Document{Position: positions[i], Blueprint: building})
+	}
+
+	resources, positions, err := readFile[*blueprints.Resource](path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	for i, resource := range resources {
+		docs = append(docs, blueprints.Document{Position: positions[i], Blueprint: resource})
+	}
+
+	research, positions, err := readFile[*blueprints.Research](path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	for i, r := range research {
+		docs = append(docs, blueprints.Document{Position: positions[i], Blueprint: r})
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || kindFiles[entry.Name()] || !IsBlueprintFile(entry.Name()) {
+			continue
+		}
+
+		mixed, err := readMixedFile(path, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		docs = append(docs, mixed...)
+	}
+
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("no blueprints found in %s", path)
+	}
+
+	return docs, nil
+}
+
+// IsBlueprintFile reports whether a file can hold blueprints, going by its name
+func IsBlueprintFile(name string) bool {
+	ext := filepath.Ext(name)
+
+	return (ext == ".yaml" || ext == ".yml") && !strings.HasPrefix(filepath.Base(name), ".")
+}
+
+// readMixedFile reads a file holding blueprints of any kind
+func readMixedFile(path, filename string) ([]blueprints.Document, error) {
+	fp, err := os.OpenFile(filepath.Join(path, filename), os.O_RDONLY, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close() // nolint
+
+	decoder := blueprints.NewDecoder(fp, filename)
+	docs := make([]blueprints.Document, 0)
+
+	for {
+		doc, err := decoder.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+func readFile[T storeable](path string) ([]T, []blueprints.Position, error) {
 	filename := ""
 
 	var collection []T
@@ -72,33 +183,70 @@ func ReadYaml[T storeable](path string) ([]T, error) {
 		filename = "buildings.yaml"
 	case []*blueprints.Resource:
 		filename = "resources.yaml"
+	case []*blueprints.Research:
+		filename = "research.yaml"
 	}
 
 	fp, err := os.OpenFile(filepath.Join(path, filename), os.O_RDONLY, os.ModePerm)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer fp.Close() // nolint
 
 	decoder := yaml.NewDecoder(fp)
 
-	var decodeError error
+	var positions []blueprints.Position
 
 	for {
-		var bp T
-		if err := decoder.Decode(&bp); err != nil {
-			decodeError = err
+		var node yaml.Node
+		if err := decoder.Decode(&node); errors.Is(err, io.EOF) {
 			break
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", filename, err)
+		}
+
+		var bp T
+		if err := node.Decode(&bp); err != nil {
+			return nil, nil, fmt.Errorf("%s (document %d): %w", filename, len(collection)+1, err)
 		}
 
 		collection = append(collection, bp)
+		positions = append(positions, blueprints.NodePosition(filename, len(collection), &node))
 	}
 
-	if decodeError.Error() != "EOF" {
-		return nil, decodeError
+	return collection, positions, nil
+}
+
+// Load validates the blueprints of a directory as a whole and pushes them into the registry,
+// see ReadDocuments for the layout of the directory
+func Load(path string) error {
+	docs, err := ReadDocuments(path)
+	if err != nil {
+		return err
+	}
+
+	if err := blueprints.Validate(docs); err != nil {
+		return err
 	}
 
-	return collection, nil
+	for _, doc := range docs {
+		var pushError error
+
+		switch bp := doc.Blueprint.(type) {
+		case *blueprints.Building:
+			pushError = Push(bp)
+		case *blueprints.Resource:
+			pushError = Push(bp)
+		case *blueprints.Research:
+			pushError = Push(bp)
+		}
+
+		if pushError != nil {
+			return pushError
+		}
+	}
+
+	return nil
 }
 
 func GetBuilding(name blueprints.BuildingName) (*blueprints.Building, error) {
@@ -119,16 +267,22 @@ func GetResource(name string) (*blueprints.Resource, error) {
 	return store.resources.Get(blueprints.ResourceName(name))
 }
 
-func GetBuildings() (map[blueprints.BuildingName]*blueprints.Building, error) {
+func GetResearch(name blueprints.ResearchName) (*blueprints.Research, error) {
 	store, err := getStore()
 	if err != nil {
 		return nil, err
 	}
 
-	store.buildings.mx.Lock()
-	defer store.buildings.mx.Unlock()
+	return store.research.Get(name)
+}
 
-	return store.buildings.store, nil
+func GetBuildings() (map[blueprints.BuildingName]*blueprints.Building, error) {
+	store, err := getStore()
+	if err != nil {
+		return nil, err
+	}
+
+	return store.buildings.All(), nil
 }
 
 func GetResources() (map[blueprints.ResourceName]*blueprints.Resource, error) {
@@ -137,10 +291,16 @@ func GetResources() (map[blueprints.ResourceName]*blueprints.Resource, error) {
 		return nil, err
 	}
 
-	store.resources.mx.Lock()
-	defer store.resources.mx.Unlock()
+	return store.resources.All(), nil
+}
+
+func GetResearches() (map[blueprints.ResearchName]*blueprints.Research, error) {
+	store, err := getStore()
+	if err != nil {
+		return nil, err
+	}
 
-	return store.resources.store, nil
+	return store.research.All(), nil
 }
 
 func getStore() (*store, error) {
@@ -148,9 +308,10 @@ func getStore() (*store, error) {
 		registry = &store{
 			buildings: newBuildingStore(),
 			resources: newResourceStore(),
+			research:  newResearchStore(),
 		}
 
-		if viper.GetString(config.Registry_Remote_Kind) != "memory" {
+		if kind := viper.GetString(config.Registry_Remote_Kind); kind != config.RegistryMemory && kind != config.RegistryFile {
 			bps, err := remote.List()
 			if err != nil {
 				return nil, err
@@ -165,32 +326,97 @@ func getStore() (*store, error) {
 				r := resource.(*blueprints.Resource)
 				registry.resources.Put(r)
 			}
+
+			for _, research := range bps["research"] {
+				r := research.(*blueprints.Research)
+				registry.research.Put(r)
+			}
 		}
 	}
 
 	return registry, nil
 }
 
+// Push checks a blueprint on its own and puts it into the registry. References to other
+// blueprints aren't checked here, as related blueprints can only be pushed one by one.
+// The version of the blueprint is set by blueprints.Revise, pushing an unchanged blueprint
+// leaves the registry as it is.
 func Push[T storeable](blueprint T) error {
+	bp, ok := any(blueprint).(blueprints.Blueprint)
+	if !ok {
+		return fmt.Errorf("invalid type %T", blueprint)
+	}
+
+	_, err := push(bp)
+
+	return err
+}
+
+// push puts a blueprint into the registry like Push, and reports whether it changed anything
+func push(blueprint blueprints.Blueprint) (bool, error) {
 	store, err := getStore()
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	switch bp := any(blueprint).(type) {
+	if err := blueprints.Check(blueprint); err != nil {
+		return false, err
+	}
+
+	var previous blueprints.Blueprint
+
+	switch bp := blueprint.(type) {
 	case *blueprints.Building:
 		if bp.ID == uuid.Nil {
 			bp.ID = game.GetBuildingID(bp.Name.String())
 		}
 
+		if existing, err := store.buildings.Get(bp.Name); err == nil {
+			previous = existing
+		}
+
+		if !blueprints.Revise(previous, bp) {
+			return false, nil
+		}
+
 		store.buildings.Put(bp)
 	case *blueprints.Resource:
+		if existing, err := store.resources.Get(bp.Name); err == nil {
+			previous = existing
+		}
+
+		if !blueprints.Revise(previous, bp) {
+			return false, nil
+		}
+
 		store.resources.Put(bp)
+	case *blueprints.Research:
+		if bp.ID == uuid.Nil {
+			bp.ID = game.GetResearchID(bp.Name.String())
+		}
+
+		if existing, err := store.research.Get(bp.Name); err == nil {
+			previous = existing
+		}
+
+		if !blueprints.Revise(previous, bp) {
+			return false, nil
+		}
+
+		store.research.Put(bp)
 	default:
-		return fmt.Errorf("invalid type %T", bp)
+		return false, fmt.Errorf("invalid type %T", bp)
 	}
 
-	return nil
+	revision.Add(1)
+
+	return true, nil
+}
+
+// Revision returns a number that changes whenever a blueprint of the registry does, so
+// inventories can tell whether they have to migrate to new blueprints
+func Revision() int64 {
+	return revision.Load()
 }
 
 func Init() error {
@@ -0,0 +1,286 @@
+package registry
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/0xa1-red/empires-of-avalon/transport"
+	"github.com/0xa1-red/empires-of-avalon/transport/memory"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDocuments(t *testing.T) {
+	docs, err := ReadDocuments("./testdata/invalid")
+	assert.NoError(t, err)
+	assert.Len(t, docs, 5)
+
+	err = blueprints.Validate(docs)
+
+	var validationError *blueprints.ValidationError
+	if !errors.As(err, &validationError) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+
+	problems := make([]string, 0)
+	for _, p := range validationError.Problems {
+		problems = append(problems, p.String())
+	}
+
+	assert.Len(t, problems, 4)
+	assert.Equal(t, `buildings.yaml:22 (document 3): building "House": duplicate name, first defined at buildings.yaml:2 (document 1)`, problems[0])
+	assert.Equal(t, `buildings.yaml:2 (document 1): building "House": generates[0].tick_length: time: unknown unit " seconds" in duration "2 seconds"`, problems[1])
+	assert.Equal(t, `buildings.yaml:14 (document 2): building "Sawmill": cost[0].resource: unknown resource "Woood"`, problems[2])
+	assert.Contains(t, problems[3], `resources.yaml:8 (document 2): resource "Population": cap_formula:`)
+}
+
+func TestPushVersions(t *testing.T) {
+	viper.Set(config.Registry_Remote_Kind, "memory")
+
+	resource := &blueprints.Resource{Name: "Clay", StartingAmount: 10} // nolint:exhaustruct
+	assert.NoError(t, Push(resource))
+	assert.Equal(t, 1, resource.Version)
+
+	revision := Revision()
+
+	// Pushing the same blueprint again doesn't change anything
+	unchanged := *resource
+	unchanged.Version = 0
+	assert.NoError(t, Push(&unchanged))
+	assert.Equal(t, 1, unchanged.Version)
+	assert.Equal(t, revision, Revision())
+
+	changed := unchanged
+	changed.StartingAmount = 20
+	assert.NoError(t, Push(&changed))
+	assert.Equal(t, 2, changed.Version)
+	assert.NotEqual(t, revision, Revision())
+
+	// Versions set by hand are kept if they are higher
+	explicit := changed
+	explicit.StartingAmount = 30
+	explicit.Version = 5
+	assert.NoError(t, Push(&explicit))
+
+	stored, err := GetResource("Clay")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, stored.Version)
+	assert.Equal(t, 30, stored.StartingAmount)
+}
+
+func TestApplyChanges(t *testing.T) {
+	viper.Set(config.Registry_Remote_Kind, "memory")
+
+	tr := memory.New()
+	transport.Set(transport.Memory(tr))
+	t.Cleanup(func() { transport.Set(nil) })
+
+	updates := make([]*protobuf.RegistryUpdate, 0)
+	_, err := tr.Subscribe(SubjectUpdates, func(update *protobuf.RegistryUpdate) {
+		updates = append(updates, update)
+	})
+	assert.NoError(t, err)
+
+	mine := &blueprints.Building{Name: "Mine", BuildTime: "10s", Version: 3} // nolint:exhaustruct
+	revision := Revision()
+
+	apply(blueprints.Change{Kind: blueprints.KindBuilding, Name: "Mine", Blueprint: mine, Revision: 42})
+
+	stored, err := GetBuilding("Mine")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, stored.Version)
+	assert.Equal(t, revision+1, Revision())
+
+	// Changes that were applied already aren't announced again
+	same := *mine
+	apply(blueprints.Change{Kind: blueprints.KindBuilding, Name: "Mine", Blueprint: &same, Revision: 43})
+	assert.Equal(t, revision+1, Revision())
+
+	apply(blueprints.Change{Kind: blueprints.KindBuilding, Name: "Mine", Blueprint: nil, Revision: 44})
+	apply(blueprints.Change{Kind: blueprints.KindBuilding, Name: "Mine", Blueprint: nil, Revision: 45})
+
+	_, err = GetBuilding("Mine")
+	assert.Error(t, err)
+	assert.Equal(t, revision+2, Revision())
+
+	tr.Flush()
+
+	if assert.Len(t, updates, 2) {
+		assert.Equal(t, int64(42), updates[0].Revision)
+		assert.Equal(t, int64(3), updates[0].Version)
+		assert.False(t, updates[0].Deleted)
+		assert.Equal(t, int64(44), updates[1].Revision)
+		assert.True(t, updates[1].Deleted)
+	}
+}
+
+func TestApplyWhileIterating(t *testing.T) {
+	viper.Set(config.Registry_Remote_Kind, "memory")
+
+	transport.Set(transport.Memory(memory.New()))
+	t.Cleanup(func() { transport.Set(nil) })
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 500; i++ {
+			quarry := &blueprints.Building{Name: "Quarry", BuildTime: "10s", Version: i + 1} // nolint:exhaustruct
+			apply(blueprints.Change{Kind: blueprints.KindBuilding, Name: "Quarry", Blueprint: quarry, Revision: int64(i)})
+
+			clay := &blueprints.Resource{Name: "Clay", Version: i + 1} // nolint:exhaustruct
+			apply(blueprints.Change{Kind: blueprints.KindResource, Name: "Clay", Blueprint: clay, Revision: int64(i)})
+
+			if i%2 == 0 {
+				apply(blueprints.Change{Kind: blueprints.KindBuilding, Name: "Quarry", Blueprint: nil, Revision: int64(i)})
+			}
+		}
+	}()
+
+	// Inventories range over the blueprints while they migrate, run with -race to catch shared maps
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+
+		buildings, err := GetBuildings()
+		assert.NoError(t, err)
+
+		for name, building := range buildings {
+			assert.Equal(t, name, building.Name)
+		}
+
+		resources, err := GetResources()
+		assert.NoError(t, err)
+
+		for name, resource := range resources {
+			assert.Equal(t, name, resource.Name)
+		}
+	}
+}
+
+func TestSyncDirectory(t *testing.T) {
+	viper.Set(config.Registry_Remote_Kind, config.RegistryFile)
+	t.Cleanup(func() { viper.Set(config.Registry_Remote_Kind, config.RegistryMemory) })
+
+	registry = nil
+	t.Cleanup(func() { registry = nil })
+
+	tr := memory.New()
+	transport.Set(transport.Memory(tr))
+	t.Cleanup(func() { transport.Set(nil) })
+
+	updates := make([]*protobuf.RegistryUpdate, 0)
+	_, err := tr.Subscribe(SubjectUpdates, func(update *protobuf.RegistryUpdate) {
+		updates = append(updates, update)
+	})
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	write := func(name, content string) {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	write("resources.yaml", "kind: Resource\nname: Wood\nstarting_amount: 100\n")
+	write("sawmill.yaml", "kind: building\nname: Sawmill\nbuild_time: 10s\ncost:\n  - resource: Wood\n    amount: 20\n")
+	write("notes.txt", "not a blueprint")
+
+	assert.NoError(t, syncDirectory(dir))
+
+	sawmill, err := GetBuilding("Sawmill")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sawmill.Version)
+
+	// Unchanged files aren't announced again
+	assert.NoError(t, syncDirectory(dir))
+
+	write("sawmill.yaml", "kind: building\nname: Sawmill\nbuild_time: 20s\ncost:\n  - resource: Wood\n    amount: 20\n")
+	assert.NoError(t, syncDirectory(dir))
+
+	sawmill, err = GetBuilding("Sawmill")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sawmill.Version)
+	assert.Equal(t, "20s", sawmill.BuildTime)
+
+	// Invalid changes leave the registry as it is
+	write("sawmill.yaml", "kind: building\nname: Sawmill\nbuild_time: 30s\ncost:\n  - resource: Stone\n    amount: 20\n")
+	assert.Error(t, syncDirectory(dir))
+
+	sawmill, err = GetBuilding("Sawmill")
+	assert.NoError(t, err)
+	assert.Equal(t, "20s", sawmill.BuildTime)
+
+	assert.NoError(t, os.Remove(filepath.Join(dir, "sawmill.yaml")))
+	assert.NoError(t, syncDirectory(dir))
+
+	_, err = GetBuilding("Sawmill")
+	assert.Error(t, err)
+
+	_, err = GetResource("Wood")
+	assert.NoError(t, err)
+
+	tr.Flush()
+
+	if assert.Len(t, updates, 4) {
+		assert.Equal(t, "Wood", updates[0].Name)
+		assert.Equal(t, "Sawmill", updates[1].Name)
+		assert.Equal(t, int64(2), updates[2].Version)
+		assert.True(t, updates[3].Deleted)
+	}
+}
+
+func TestReadMixedDocuments(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "farm.yml"), []byte("kind: resource\nname: Grain\n---\nkind: mine\nname: Quarry\n"), 0o600))
+
+	_, err := ReadDocuments(dir)
+	assert.EqualError(t, err, `farm.yml (document 2): unknown blueprint kind "mine"`)
+
+	_, err = ReadDocuments(t.TempDir())
+	assert.Error(t, err)
+}
+
+func TestWriteDocuments(t *testing.T) {
+	refund := 50
+	bps := map[string]map[string]blueprints.Blueprint{
+		blueprints.KindBuilding: {
+			"Sawmill": &blueprints.Building{ // nolint:exhaustruct
+				Name:         "Sawmill",
+				BuildTime:    "10s",
+				Cost:         []blueprints.ResourceCost{{Resource: "Wood", Amount: 20, Permanent: true}},
+				CancelRefund: &refund,
+				Version:      3,
+			},
+			"House": &blueprints.Building{Name: "House", BuildTime: "5s", Version: 1}, // nolint:exhaustruct
+		},
+		blueprints.KindResource: {
+			"Wood": &blueprints.Resource{Name: "Wood", StartingAmount: 100, Version: 2}, // nolint:exhaustruct
+		},
+	}
+
+	dir := t.TempDir()
+	assert.NoError(t, WriteDocuments(dir, bps))
+
+	_, err := os.Stat(filepath.Join(dir, "research.yaml"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	docs, err := ReadDocuments(dir)
+	assert.NoError(t, err)
+
+	if assert.Len(t, docs, 3) {
+		assert.Equal(t, "House", docs[0].Blueprint.GetName())
+		assert.Equal(t, 2, docs[1].Position.Document)
+
+		for _, doc := range docs {
+			assert.True(t, blueprints.Equal(bps[doc.Blueprint.Kind()][doc.Blueprint.GetName()], doc.Blueprint), doc.Blueprint.GetName())
+		}
+	}
+}
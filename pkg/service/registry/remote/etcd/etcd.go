@@ -1,23 +1,29 @@
 package etcd
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/0xa1-red/empires-of-avalon/config"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
 	"github.com/spf13/viper"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"golang.org/x/exp/slog"
 	"google.golang.org/grpc"
 )
 
+var ErrNotFound = fmt.Errorf("etcd: %w", blueprints.ErrNotFound)
+
 type Store struct {
 	*clientv3.Client
+
+	// revision is the etcd revision of the last List, watches continue from there
+	revision atomic.Int64
 }
 
 func namespace(kind, userKey string) string {
@@ -49,18 +55,16 @@ func New() (*Store, error) {
 		return nil, err
 	}
 
-	return &Store{c}, nil
+	return &Store{Client: c}, nil // nolint:exhaustruct
 }
 
 func (s *Store) Push(blueprint blueprints.Blueprint) error {
-	buf := bytes.NewBufferString("")
-	encoder := json.NewEncoder(buf)
-
-	if err := encoder.Encode(blueprint); err != nil {
+	data, err := blueprint.Encode()
+	if err != nil {
 		return err
 	}
 
-	if _, err := s.Put(context.Background(), namespace(blueprint.Kind(), blueprint.GetName()), buf.String()); err != nil {
+	if _, err := s.Put(context.Background(), namespace(blueprint.Kind(), blueprint.GetName()), string(data)); err != nil {
 		return err
 	}
 
@@ -77,6 +81,8 @@ func (s *Store) List() (map[string]map[string]blueprints.Blueprint, error) {
 
 	result := make(map[string]map[string]blueprints.Blueprint)
 
+	s.revision.Store(resp.Header.Revision)
+
 	if resp.Count == 0 {
 		return result, nil
 	}
@@ -84,23 +90,12 @@ func (s *Store) List() (map[string]map[string]blueprints.Blueprint, error) {
 	for _, kv := range resp.Kvs {
 		key := strings.Split(string(kv.Key), "/")
 
-		var (
-			blueprint   blueprints.Blueprint
-			decodeError error
-		)
-
 		kind := key[1]
 
-		switch kind {
-		case "building":
-			blueprint, decodeError = decode[*blueprints.Building](kv.Value)
-		case "resource":
-			blueprint, decodeError = decode[*blueprints.Resource](kv.Value)
-		default:
+		blueprint, decodeError := blueprints.Decode(kind, kv.Value)
+		if errors.Is(decodeError, blueprints.ErrUnknownKind) {
 			continue
-		}
-
-		if decodeError != nil {
+		} else if decodeError != nil {
 			slog.Warn("failed to decode blueprint", "key", kv.Key)
 			break
 		}
@@ -126,36 +121,124 @@ func (s *Store) Get(kind, name string) (blueprints.Blueprint, error) {
 	}
 
 	if resp.Count == 0 {
-		return nil, fmt.Errorf("Not found")
+		return nil, ErrNotFound
 	}
 
-	buf := bytes.NewBuffer(resp.Kvs[0].Value)
-	decoder := json.NewDecoder(buf)
+	return blueprints.Decode(kind, resp.Kvs[0].Value)
+}
 
-	var res blueprints.Blueprint
+// Delete removes a blueprint, the watches see it as a deletion
+func (s *Store) Delete(kind, name string) error {
+	resp, err := s.Client.Delete(context.Background(), namespace(kind, name))
+	if err != nil {
+		return err
+	}
 
-	switch kind {
-	case blueprints.KindBuilding:
-		res = &blueprints.Building{} // nolint:exhaustruct
-	case blueprints.KindResource:
-		res = &blueprints.Resource{} // nolint:exhaustruct
+	if resp.Deleted == 0 {
+		return ErrNotFound
 	}
 
-	if err := decoder.Decode(&res); err != nil {
+	return nil
+}
+
+// History returns the versions of a blueprint etcd still has, the latest first. Versions from
+// before the last compaction or before the blueprint was last deleted are gone.
+func (s *Store) History(kind, name string) ([]blueprints.Blueprint, error) {
+	key := namespace(kind, name)
+
+	resp, err := s.Client.Get(context.Background(), key)
+	if err != nil {
 		return nil, err
 	}
 
-	return res, nil
+	if resp.Count == 0 {
+		return nil, ErrNotFound
+	}
+
+	history := make([]blueprints.Blueprint, 0)
+	kv := resp.Kvs[0]
+
+	for {
+		blueprint, err := blueprints.Decode(kind, kv.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, blueprint)
+
+		// Version counts the puts since the key was created, the first one has nothing before it
+		if kv.Version <= 1 {
+			break
+		}
+
+		resp, err := s.Client.Get(context.Background(), key, clientv3.WithRev(kv.ModRevision-1))
+		if errors.Is(err, rpctypes.ErrCompacted) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if resp.Count == 0 {
+			break
+		}
+
+		kv = resp.Kvs[0]
+	}
+
+	return history, nil
+}
+
+// Revision returns the etcd revision the blueprints were last read at
+func (s *Store) Revision() int64 {
+	return s.revision.Load()
 }
 
-func decode[T *blueprints.Resource | *blueprints.Building](d []byte) (T, error) {
-	buf := bytes.NewBuffer(d)
-	decoder := json.NewDecoder(buf)
-	dst := new(T)
+// Watch calls fn with every change of the blueprints after the last List until the context is done
+// or the watch fails, eg. because the revision it continues from was compacted already
+func (s *Store) Watch(ctx context.Context, fn func(blueprints.Change)) error {
+	prefix := viper.GetString(config.Registry_Etcd_Key_Root) + viper.GetString(config.Registry_Etcd_Key_Separator)
+	options := []clientv3.OpOption{clientv3.WithPrefix()}
 
-	if err := decoder.Decode(dst); err != nil {
-		return nil, err
+	if revision := s.revision.Load(); revision > 0 {
+		options = append(options, clientv3.WithRev(revision+1))
+	}
+
+	slog.Debug("watching blueprints", "prefix", prefix, "revision", s.revision.Load())
+
+	for resp := range s.Client.Watch(ctx, prefix, options...) {
+		if err := resp.Err(); err != nil {
+			return err
+		}
+
+		for _, event := range resp.Events {
+			kind, name, ok := strings.Cut(strings.TrimPrefix(string(event.Kv.Key), prefix), viper.GetString(config.Registry_Etcd_Key_Separator))
+			if !ok {
+				continue
+			}
+
+			change := blueprints.Change{
+				Kind:      kind,
+				Name:      name,
+				Blueprint: nil,
+				Revision:  event.Kv.ModRevision,
+			}
+
+			if event.Type == clientv3.EventTypePut {
+				var decodeError error
+
+				change.Blueprint, decodeError = blueprints.Decode(kind, event.Kv.Value)
+				if errors.Is(decodeError, blueprints.ErrUnknownKind) {
+					continue
+				} else if decodeError != nil {
+					slog.Warn("failed to decode blueprint", "key", string(event.Kv.Key), "error", decodeError)
+					continue
+				}
+			}
+
+			s.revision.Store(event.Kv.ModRevision)
+			fn(change)
+		}
 	}
 
-	return *dst, nil
+	return ctx.Err()
 }
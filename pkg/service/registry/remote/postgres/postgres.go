@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/database"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slog"
+)
+
+var ErrNotFound = fmt.Errorf("postgres: %w", blueprints.ErrNotFound)
+
+// Row is a version of a blueprint. Every push adds a row, so the table holds the history of
+// every blueprint, and the latest row of a blueprint is its current version. Deleting a blueprint
+// adds a row marked as deleted, which hides the blueprint until it's pushed again.
+type Row struct {
+	ID        uuid.UUID `db:"id"`
+	Kind      string    `db:"kind"`
+	Data      []byte    `db:"data"`
+	Deleted   bool      `db:"deleted"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type Store struct {
+	db *database.Conn
+}
+
+func New() *Store {
+	return &Store{
+		db: database.Connection(),
+	}
+}
+
+func (s *Store) Push(blueprint blueprints.Blueprint) error {
+	data, err := blueprint.Encode()
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.db.Exec("INSERT INTO blueprints (id, kind, data) VALUES ($1, $2, $3)",
+		uuid.New(),
+		blueprint.Kind(),
+		data,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Store) List() (map[string]map[string]blueprints.Blueprint, error) {
+	rows := []Row{}
+
+	if err := s.db.Select(&rows, "SELECT id, kind, data, deleted, created_at FROM (SELECT DISTINCT ON (kind, data->>'name') id, kind, data, deleted, created_at FROM blueprints ORDER BY kind, data->>'name', created_at DESC) latest WHERE NOT deleted"); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]map[string]blueprints.Blueprint)
+
+	for _, row := range rows {
+		blueprint, err := decode(row)
+		if err != nil {
+			slog.Warn("failed to decode blueprint", "id", row.ID.String(), "kind", row.Kind, "error", err)
+			continue
+		}
+
+		if _, ok := result[row.Kind]; !ok {
+			result[row.Kind] = make(map[string]blueprints.Blueprint)
+		}
+
+		result[row.Kind][blueprint.GetName()] = blueprint
+	}
+
+	return result, nil
+}
+
+func (s *Store) Get(kind, name string) (blueprints.Blueprint, error) {
+	row := Row{} // nolint:exhaustruct
+
+	err := s.db.Get(&row, "SELECT id, kind, data, deleted, created_at FROM blueprints WHERE kind = $1 AND data->>'name' = $2 ORDER BY created_at DESC LIMIT 1",
+		kind,
+		name,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if row.Deleted {
+		return nil, ErrNotFound
+	}
+
+	return decode(row)
+}
+
+// History returns every version of a blueprint that was pushed, the latest first, including the
+// versions from before the blueprint was deleted
+func (s *Store) History(kind, name string) ([]blueprints.Blueprint, error) {
+	rows := []Row{}
+
+	if err := s.db.Select(&rows, "SELECT id, kind, data, deleted, created_at FROM blueprints WHERE kind = $1 AND data->>'name' = $2 AND NOT deleted ORDER BY created_at DESC",
+		kind,
+		name,
+	); err != nil {
+		return nil, err
+	}
+
+	history := make([]blueprints.Blueprint, 0, len(rows))
+
+	for _, row := range rows {
+		blueprint, err := decode(row)
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, blueprint)
+	}
+
+	return history, nil
+}
+
+// Delete marks a blueprint as deleted. Its versions are kept, so it can be rolled back.
+func (s *Store) Delete(kind, name string) error {
+	blueprint, err := s.Get(kind, name)
+	if err != nil {
+		return err
+	}
+
+	// The deleted row holds the last version, so it's found by name like the others
+	data, err := blueprint.Encode()
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.db.Exec("INSERT INTO blueprints (id, kind, data, deleted) VALUES ($1, $2, $3, true)",
+		uuid.New(),
+		kind,
+		data,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func decode(row Row) (blueprints.Blueprint, error) {
+	return blueprints.Decode(row.Kind, row.Data)
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	refund := 50
+	building := &blueprints.Building{ // nolint:exhaustruct
+		Name:         "Sawmill",
+		BuildTime:    "10s",
+		CancelRefund: &refund,
+		Cost:         []blueprints.ResourceCost{{Resource: "Wood", Amount: 10, Permanent: true}},
+		Version:      3,
+	}
+
+	data, err := building.Encode()
+	assert.NoError(t, err)
+
+	decoded, err := decode(Row{Kind: blueprints.KindBuilding, Data: data}) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, building, decoded)
+
+	_, err = decode(Row{Kind: "unknown", Data: data}) // nolint:exhaustruct
+	assert.Error(t, err)
+}
@@ -1,11 +1,14 @@
 package remote
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/0xa1-red/empires-of-avalon/config"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry/remote/etcd"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry/remote/postgres"
 	"github.com/spf13/viper"
 )
 
@@ -13,10 +16,30 @@ type backend interface {
 	Push(bp blueprints.Blueprint) error
 	List() (map[string]map[string]blueprints.Blueprint, error)
 	Get(kind, key string) (blueprints.Blueprint, error)
+	Delete(kind, key string) error
+}
+
+// watcher is implemented by the backends that can stream their changes
+type watcher interface {
+	Watch(ctx context.Context, fn func(blueprints.Change)) error
+}
+
+// historian is implemented by the backends that keep the previous versions of the blueprints
+type historian interface {
+	History(kind, name string) ([]blueprints.Blueprint, error)
 }
 
 const (
-	KindEtcd string = "etcd"
+	KindEtcd     string = "etcd"
+	KindPostgres string = "postgres"
+)
+
+var (
+	// ErrNotFound is wrapped by the errors of every backend for blueprints it doesn't have
+	ErrNotFound = blueprints.ErrNotFound
+
+	ErrWatchUnsupported   = errors.New("the remote registry can't be watched")
+	ErrHistoryUnsupported = errors.New("the remote registry doesn't keep the history of the blueprints")
 )
 
 var connection backend
@@ -31,6 +54,8 @@ func getConnection() (backend, error) {
 			}
 
 			connection = c
+		case KindPostgres:
+			connection = postgres.New()
 		default:
 			return nil, fmt.Errorf("invalid backend kind %s", viper.GetString(config.Registry_Remote_Kind))
 		}
@@ -39,12 +64,32 @@ func getConnection() (backend, error) {
 	return connection, nil
 }
 
+// Push checks a blueprint on its own and stores it in the remote registry, see blueprints.Check.
+// The version is set against the stored blueprint by blueprints.Revise, unchanged blueprints
+// aren't stored again.
 func Push(bp blueprints.Blueprint) error {
+	if err := blueprints.Check(bp); err != nil {
+		return err
+	}
+
 	c, err := getConnection()
 	if err != nil {
 		return err
 	}
 
+	// Only a blueprint the backend doesn't have starts over at the first version, failing to read
+	// the stored one would reset the version the inventories migrate on
+	previous, err := c.Get(bp.Kind(), bp.GetName())
+	if errors.Is(err, ErrNotFound) {
+		previous = nil
+	} else if err != nil {
+		return err
+	}
+
+	if !blueprints.Revise(previous, bp) {
+		return nil
+	}
+
 	return c.Push(bp)
 }
 
@@ -65,3 +110,89 @@ func Get(kind, key string) (blueprints.Blueprint, error) {
 
 	return c.Get(kind, key)
 }
+
+// Delete removes a blueprint from the remote registry
+func Delete(kind, key string) error {
+	c, err := getConnection()
+	if err != nil {
+		return err
+	}
+
+	return c.Delete(kind, key)
+}
+
+// revisioner is implemented by the backends that keep track of their revision
+type revisioner interface {
+	Revision() int64
+}
+
+// Revision returns the revision of the remote registry the last List or change was read at,
+// or 0 if the backend has no revisions
+func Revision() int64 {
+	c, err := getConnection()
+	if err != nil {
+		return 0
+	}
+
+	if r, ok := c.(revisioner); ok {
+		return r.Revision()
+	}
+
+	return 0
+}
+
+// Watch calls fn with every change of the remote registry after the last List, until the context
+// is done or the watch fails
+func Watch(ctx context.Context, fn func(blueprints.Change)) error {
+	c, err := getConnection()
+	if err != nil {
+		return err
+	}
+
+	w, ok := c.(watcher)
+	if !ok {
+		return ErrWatchUnsupported
+	}
+
+	return w.Watch(ctx, fn)
+}
+
+// History returns every version of a blueprint the remote registry has, the latest first
+func History(kind, name string) ([]blueprints.Blueprint, error) {
+	c, err := getConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	h, ok := c.(historian)
+	if !ok {
+		return nil, ErrHistoryUnsupported
+	}
+
+	return h.History(kind, name)
+}
+
+// Rollback pushes an earlier version of a blueprint again. The blueprint gets the next version, so
+// the inventories migrate to it like to any other change, also when it was deleted in the meantime.
+func Rollback(kind, name string, version int) (blueprints.Blueprint, error) {
+	history, err := History(kind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bp := range history {
+		if bp.GetVersion() != version {
+			continue
+		}
+
+		bp.SetVersion(history[0].GetVersion() + 1)
+
+		if err := Push(bp); err != nil {
+			return nil, err
+		}
+
+		return bp, nil
+	}
+
+	return nil, fmt.Errorf("%s %q has no version %d", kind, name, version)
+}
@@ -0,0 +1,72 @@
+package remote
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubBackend returns err from Get and records the pushed blueprints
+type stubBackend struct {
+	backend
+
+	err     error
+	pushed  []blueprints.Blueprint
+	history []blueprints.Blueprint
+}
+
+func (s *stubBackend) History(kind, name string) ([]blueprints.Blueprint, error) {
+	return s.history, nil
+}
+
+func (s *stubBackend) Get(kind, key string) (blueprints.Blueprint, error) {
+	return nil, s.err
+}
+
+func (s *stubBackend) Push(bp blueprints.Blueprint) error {
+	s.pushed = append(s.pushed, bp)
+	return nil
+}
+
+func TestPush(t *testing.T) {
+	defer func() { connection = nil }()
+
+	stub := &stubBackend{err: fmt.Errorf("etcd: %w", ErrNotFound)} // nolint:exhaustruct
+	connection = stub
+
+	resource := &blueprints.Resource{Name: "Wood"} // nolint:exhaustruct
+	assert.NoError(t, Push(resource))
+	assert.Equal(t, 1, resource.Version)
+	assert.Len(t, stub.pushed, 1)
+
+	// A blueprint that can't be read isn't stored over with the first version
+	stub.err = errors.New("connection refused")
+	assert.EqualError(t, Push(resource), "connection refused")
+	assert.Len(t, stub.pushed, 1)
+}
+
+func TestRollback(t *testing.T) {
+	defer func() { connection = nil }()
+
+	// The blueprint was deleted after its third version
+	stub := &stubBackend{ // nolint:exhaustruct
+		err: fmt.Errorf("postgres: %w", ErrNotFound),
+		history: []blueprints.Blueprint{
+			&blueprints.Resource{Name: "Wood", StartingAmount: 30, Version: 3}, // nolint:exhaustruct
+			&blueprints.Resource{Name: "Wood", StartingAmount: 20, Version: 2}, // nolint:exhaustruct
+			&blueprints.Resource{Name: "Wood", StartingAmount: 10, Version: 1}, // nolint:exhaustruct
+		},
+	}
+	connection = stub
+
+	bp, err := Rollback(blueprints.KindResource, "Wood", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, &blueprints.Resource{Name: "Wood", StartingAmount: 20, Version: 4}, bp) // nolint:exhaustruct
+	assert.Equal(t, []blueprints.Blueprint{bp}, stub.pushed)
+
+	_, err = Rollback(blueprints.KindResource, "Wood", 5)
+	assert.EqualError(t, err, `resource "Wood" has no version 5`)
+}
@@ -0,0 +1,68 @@
+package registry
+
+import (
+	"fmt"
+	"maps"
+	"sync"
+
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"golang.org/x/exp/slog"
+)
+
+type ResearchStore struct {
+	mx *sync.Mutex
+
+	store map[blueprints.ResearchName]*blueprints.Research
+}
+
+func newResearchStore() *ResearchStore {
+	return &ResearchStore{
+		mx:    &sync.Mutex{},
+		store: make(map[blueprints.ResearchName]*blueprints.Research),
+	}
+}
+
+func (s *ResearchStore) Put(item *blueprints.Research) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	slog.Debug("registering research blueprint", "id", item.ID.String(), "name", item.Name.String(), "version", item.Version)
+
+	s.store[item.Name] = item
+}
+
+func (s *ResearchStore) Get(name blueprints.ResearchName) (*blueprints.Research, error) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	item, ok := s.store[name]
+	if !ok {
+		return nil, fmt.Errorf("not found")
+	}
+
+	return item, nil
+}
+
+// All returns a copy of the store, so it can be iterated while blueprints change
+func (s *ResearchStore) All() map[blueprints.ResearchName]*blueprints.Research {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return maps.Clone(s.store)
+}
+
+// Delete removes a blueprint from the store and reports whether it was there
+func (s *ResearchStore) Delete(name blueprints.ResearchName) bool {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if _, ok := s.store[name]; !ok {
+		return false
+	}
+
+	slog.Debug("removing research blueprint", "name", name.String())
+
+	delete(s.store, name)
+
+	return true
+}
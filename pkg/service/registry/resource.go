@@ -2,6 +2,7 @@ package registry
 
 import (
 	"fmt"
+	"maps"
 	"sync"
 
 	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
@@ -25,7 +26,7 @@ func (s *ResourceStore) Put(item *blueprints.Resource) {
 	s.mx.Lock()
 	defer s.mx.Unlock()
 
-	slog.Debug("registering resource blueprint", "name", item.Name.String())
+	slog.Debug("registering resource blueprint", "name", item.Name.String(), "version", item.Version)
 
 	s.store[item.Name] = item
 }
@@ -41,3 +42,27 @@ func (s *ResourceStore) Get(name blueprints.ResourceName) (*blueprints.Resource,
 
 	return item, nil
 }
+
+// All returns a copy of the store, so it can be iterated while blueprints change
+func (s *ResourceStore) All() map[blueprints.ResourceName]*blueprints.Resource {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	return maps.Clone(s.store)
+}
+
+// Delete removes a blueprint from the store and reports whether it was there
+func (s *ResourceStore) Delete(name blueprints.ResourceName) bool {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if _, ok := s.store[name]; !ok {
+		return false
+	}
+
+	slog.Debug("removing resource blueprint", "name", name.String())
+
+	delete(s.store, name)
+
+	return true
+}
@@ -0,0 +1,227 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry/remote"
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/0xa1-red/empires-of-avalon/transport"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SubjectUpdates is the transport subject the changes of the registry are announced on
+const SubjectUpdates = "registry-updates"
+
+// watchRetry is how long Watch waits before reloading the registry after the watch broke
+const watchRetry = 5 * time.Second
+
+// Watch keeps the registry up to date with the remote one until the context is done, and announces
+// every change on SubjectUpdates. If the watch breaks, the registry is reloaded and watched again.
+// The file registry watches the blueprint directory instead.
+func Watch(ctx context.Context) {
+	if viper.GetString(config.Registry_Remote_Kind) == config.RegistryFile {
+		watchDirectory(ctx, viper.GetString(config.Blueprint_Path))
+		return
+	}
+
+	for {
+		err := remote.Watch(ctx, apply)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if errors.Is(err, remote.ErrWatchUnsupported) {
+			slog.Info("the remote registry can't be watched, blueprints are only loaded on start")
+			return
+		}
+
+		slog.Warn("blueprint watch stopped, reloading the registry", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRetry):
+		}
+
+		if err := reload(); err != nil {
+			slog.Error("failed to reload the registry", err)
+		}
+	}
+}
+
+// Status describes the registry for the admin dashboard
+type Status struct {
+	// RemoteRevision is the revision of the remote registry the blueprints are at
+	RemoteRevision int64
+	// Revision is the local revision, see Revision
+	Revision  int64
+	Buildings int
+	Resources int
+	Research  int
+}
+
+func GetStatus() (Status, error) {
+	store, err := getStore()
+	if err != nil {
+		return Status{}, err // nolint:exhaustruct
+	}
+
+	names := store.names()
+
+	return Status{
+		RemoteRevision: remote.Revision(),
+		Revision:       Revision(),
+		Buildings:      len(names[blueprints.KindBuilding]),
+		Resources:      len(names[blueprints.KindResource]),
+		Research:       len(names[blueprints.KindResearch]),
+	}, nil
+}
+
+// apply puts a changed blueprint into the registry or deletes it, and announces the change
+func apply(change blueprints.Change) {
+	store, err := getStore()
+	if err != nil {
+		slog.Error("failed to apply blueprint change", err, "kind", change.Kind, "name", change.Name)
+		return
+	}
+
+	if !store.apply(change) {
+		return
+	}
+
+	revision.Add(1)
+
+	slog.Info("applied blueprint change", "kind", change.Kind, "name", change.Name, "deleted", change.Blueprint == nil)
+
+	announce(change)
+}
+
+// reload replaces the blueprints of the registry with the ones in the remote registry
+func reload() error {
+	bps, err := remote.List()
+	if err != nil {
+		return err
+	}
+
+	store, err := getStore()
+	if err != nil {
+		return err
+	}
+
+	for kind, names := range store.names() {
+		for _, name := range names {
+			if _, ok := bps[kind][name]; !ok {
+				apply(blueprints.Change{Kind: kind, Name: name, Blueprint: nil, Revision: 0})
+			}
+		}
+	}
+
+	for kind, items := range bps {
+		for name, bp := range items {
+			apply(blueprints.Change{Kind: kind, Name: name, Blueprint: bp, Revision: 0})
+		}
+	}
+
+	return nil
+}
+
+func announce(change blueprints.Change) {
+	conn, err := transport.Get()
+	if err != nil {
+		slog.Warn("failed to announce blueprint change", "error", err)
+		return
+	}
+
+	update := &protobuf.RegistryUpdate{
+		Revision:  change.Revision,
+		Kind:      change.Kind,
+		Name:      change.Name,
+		Version:   0,
+		Deleted:   change.Blueprint == nil,
+		Timestamp: timestamppb.Now(),
+	}
+
+	if change.Blueprint != nil {
+		update.Version = int64(change.Blueprint.GetVersion())
+	}
+
+	if err := conn.Publish(SubjectUpdates, update); err != nil {
+		slog.Warn("failed to announce blueprint change", "error", err, "kind", change.Kind, "name", change.Name)
+	}
+}
+
+// apply reports whether the change had any effect, blueprints equal to the stored ones don't
+func (s *store) apply(change blueprints.Change) bool {
+	if change.Blueprint == nil {
+		switch change.Kind {
+		case blueprints.KindBuilding:
+			return s.buildings.Delete(blueprints.BuildingName(change.Name))
+		case blueprints.KindResource:
+			return s.resources.Delete(blueprints.ResourceName(change.Name))
+		case blueprints.KindResearch:
+			return s.research.Delete(blueprints.ResearchName(change.Name))
+		}
+
+		return false
+	}
+
+	switch bp := change.Blueprint.(type) {
+	case *blueprints.Building:
+		if existing, err := s.buildings.Get(bp.Name); err == nil && blueprints.Equal(existing, bp) {
+			return false
+		}
+
+		s.buildings.Put(bp)
+	case *blueprints.Resource:
+		if existing, err := s.resources.Get(bp.Name); err == nil && blueprints.Equal(existing, bp) {
+			return false
+		}
+
+		s.resources.Put(bp)
+	case *blueprints.Research:
+		if existing, err := s.research.Get(bp.Name); err == nil && blueprints.Equal(existing, bp) {
+			return false
+		}
+
+		s.research.Put(bp)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// names returns the names of the blueprints in the store by kind
+func (s *store) names() map[string][]string {
+	names := map[string][]string{
+		blueprints.KindBuilding: make([]string, 0),
+		blueprints.KindResource: make([]string, 0),
+		blueprints.KindResearch: make([]string, 0),
+	}
+
+	s.buildings.mx.Lock()
+	for name := range s.buildings.store {
+		names[blueprints.KindBuilding] = append(names[blueprints.KindBuilding], name.String())
+	}
+	s.buildings.mx.Unlock()
+
+	s.resources.mx.Lock()
+	for name := range s.resources.store {
+		names[blueprints.KindResource] = append(names[blueprints.KindResource], name.String())
+	}
+	s.resources.mx.Unlock()
+
+	s.research.mx.Lock()
+	for name := range s.research.store {
+		names[blueprints.KindResearch] = append(names[blueprints.KindResearch], name.String())
+	}
+	s.research.mx.Unlock()
+
+	return names
+}
@@ -0,0 +1,69 @@
+package simulation
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// WriteCSV writes the samples of a simulation as a table, with a column for the amount of every
+// resource and the number of every building
+func WriteCSV(w io.Writer, result *Result) error {
+	resources := make(map[string]struct{})
+	buildings := make(map[string]struct{})
+
+	for _, sample := range result.Samples {
+		for name := range sample.Resources {
+			resources[name] = struct{}{}
+		}
+
+		for name := range sample.Buildings {
+			buildings[name] = struct{}{}
+		}
+	}
+
+	resourceNames := sortedKeys(resources)
+	buildingNames := sortedKeys(buildings)
+
+	header := []string{"seconds"}
+	header = append(header, resourceNames...)
+
+	for _, name := range buildingNames {
+		header = append(header, "building:"+name)
+	}
+
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, sample := range result.Samples {
+		record := []string{strconv.FormatInt(sample.Seconds, 10)}
+
+		for _, name := range resourceNames {
+			record = append(record, strconv.Itoa(sample.Resources[name]))
+		}
+
+		for _, name := range buildingNames {
+			record = append(record, strconv.Itoa(sample.Buildings[name]))
+		}
+
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// WriteJSON writes the samples and milestones of a simulation
+func WriteJSON(w io.Writer, result *Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
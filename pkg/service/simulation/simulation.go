@@ -0,0 +1,326 @@
+package simulation
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/actor/inventory"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/blueprints"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	"gopkg.in/yaml.v3"
+)
+
+// Step is an entry of a build order, either a number of buildings or a research
+type Step struct {
+	Building string `yaml:"building"`
+	Amount   int    `yaml:"amount"`
+	Research string `yaml:"research"`
+}
+
+// ReadScript reads a build order from a YAML file holding a list of steps
+func ReadScript(path string) ([]Step, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close() // nolint
+
+	steps := make([]Step, 0)
+	if err := yaml.NewDecoder(fp).Decode(&steps); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	for i, step := range steps {
+		if (step.Building == "") == (step.Research == "") {
+			return nil, fmt.Errorf("%s: step %d: needs either a building or a research", path, i+1)
+		}
+
+		if step.Amount < 1 {
+			steps[i].Amount = 1
+		}
+	}
+
+	return steps, nil
+}
+
+// Options control a simulation
+type Options struct {
+	Duration time.Duration
+	// Interval is how often the resources are sampled. The build order is also advanced at every
+	// sample and whenever a construction or research finishes.
+	Interval time.Duration
+	// Script is the build order to follow, the greedy one is used if it's empty
+	Script []Step
+}
+
+// Sample is the inventory at a point of the simulation
+type Sample struct {
+	Seconds   int64          `json:"seconds"`
+	Resources map[string]int `json:"resources"`
+	Buildings map[string]int `json:"buildings"`
+}
+
+// Milestone is the first time a building was completed or a research was finished
+type Milestone struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Seconds int64  `json:"seconds"`
+}
+
+func (m Milestone) String() string {
+	return fmt.Sprintf("first %s %s: %s", m.Kind, m.Name, time.Duration(m.Seconds)*time.Second)
+}
+
+type Result struct {
+	Samples    []Sample    `json:"samples"`
+	Milestones []Milestone `json:"milestones"`
+}
+
+// planner starts whatever the build order calls for next
+type planner interface {
+	plan(s *inventory.Simulation) error
+}
+
+// Run simulates an inventory with the blueprints of the registry, from its starting buildings and
+// resources, following the build order of the options
+func Run(start time.Time, opts Options) (*Result, error) {
+	if opts.Duration <= 0 || opts.Interval <= 0 {
+		return nil, fmt.Errorf("duration and interval have to be positive")
+	}
+
+	var p planner = &greedy{}
+
+	if len(opts.Script) > 0 {
+		s, err := newScripted(opts.Script)
+		if err != nil {
+			return nil, err
+		}
+
+		p = s
+	}
+
+	sim := inventory.NewSimulation(start)
+	end := start.Add(opts.Duration)
+	nextSample := start
+	initial := sim.State()
+
+	result := &Result{
+		Samples:    make([]Sample, 0),
+		Milestones: make([]Milestone, 0),
+	}
+	reached := make(map[string]bool)
+
+	for {
+		if err := sim.Staff(); err != nil {
+			return nil, err
+		}
+
+		if err := p.plan(sim); err != nil {
+			return nil, err
+		}
+
+		state := sim.State()
+		result.Milestones = append(result.Milestones, milestones(start, initial, state, reached)...)
+
+		if !state.Time.Before(nextSample) {
+			result.Samples = append(result.Samples, Sample{
+				Seconds:   int64(state.Time.Sub(start) / time.Second),
+				Resources: state.Resources,
+				Buildings: state.Buildings,
+			})
+
+			nextSample = nextSample.Add(opts.Interval)
+		}
+
+		if !state.Time.Before(end) {
+			break
+		}
+
+		next := nextSample
+		if next.After(end) {
+			next = end
+		}
+
+		if deadline, ok := sim.Next(); ok && deadline.Before(next) {
+			next = deadline
+		}
+
+		sim.AdvanceTo(next)
+	}
+
+	return result, nil
+}
+
+// milestones returns the buildings and research reached for the first time
+func milestones(start time.Time, initial, state inventory.SimulationState, reached map[string]bool) []Milestone {
+	res := make([]Milestone, 0)
+	elapsed := int64(state.Time.Sub(start) / time.Second)
+
+	for _, name := range sortedKeys(state.Buildings) {
+		key := blueprints.KindBuilding + ":" + name
+		if reached[key] || state.Buildings[name] <= initial.Buildings[name] {
+			continue
+		}
+
+		reached[key] = true
+		res = append(res, Milestone{Kind: blueprints.KindBuilding, Name: name, Seconds: elapsed})
+	}
+
+	for _, name := range sortedKeys(state.Research) {
+		key := blueprints.KindResearch + ":" + name
+		if reached[key] {
+			continue
+		}
+
+		reached[key] = true
+		res = append(res, Milestone{
+			Kind:    blueprints.KindResearch,
+			Name:    name,
+			Seconds: int64(state.Research[name].Sub(start) / time.Second),
+		})
+	}
+
+	return res
+}
+
+// greedy keeps the construction slots busy with the affordable building the inventory has the
+// fewest of, and researches whatever it can afford, one research at a time
+type greedy struct{}
+
+func (g *greedy) plan(s *inventory.Simulation) error {
+	buildings, err := registry.GetBuildings()
+	if err != nil {
+		return err
+	}
+
+	for s.FreeSlots() > 0 {
+		state := s.State()
+
+		names := make([]string, 0, len(buildings))
+		for name := range buildings {
+			names = append(names, name.String())
+		}
+
+		sort.Slice(names, func(i, j int) bool {
+			a := state.Buildings[names[i]] + state.Queued[names[i]]
+			b := state.Buildings[names[j]] + state.Queued[names[j]]
+
+			if a == b {
+				return names[i] < names[j]
+			}
+
+			return a < b
+		})
+
+		started := false
+
+		for _, name := range names {
+			if s.StartBuilding(name) == nil {
+				started = true
+				break
+			}
+		}
+
+		if !started {
+			break
+		}
+	}
+
+	if s.Researching() {
+		return nil
+	}
+
+	research, err := registry.GetResearches()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(research))
+	for name := range research {
+		names = append(names, name.String())
+	}
+
+	sort.Strings(names)
+
+	completed := s.State().Research
+
+	for _, name := range names {
+		if _, ok := completed[name]; ok {
+			continue
+		}
+
+		if s.StartResearch(name) == nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// scripted follows a build order step by step, waiting at every step until it can be started
+type scripted struct {
+	steps []Step
+	// started is the number of buildings of the current step that were requested
+	started int
+}
+
+func newScripted(steps []Step) (*scripted, error) {
+	for i, step := range steps {
+		if step.Building != "" {
+			if _, err := registry.GetBuilding(blueprints.BuildingName(step.Building)); err != nil {
+				return nil, fmt.Errorf("step %d: unknown building %q", i+1, step.Building)
+			}
+		}
+
+		if step.Research != "" {
+			if _, err := registry.GetResearch(blueprints.ResearchName(step.Research)); err != nil {
+				return nil, fmt.Errorf("step %d: unknown research %q", i+1, step.Research)
+			}
+		}
+	}
+
+	return &scripted{steps: steps, started: 0}, nil
+}
+
+func (sc *scripted) plan(s *inventory.Simulation) error {
+	for len(sc.steps) > 0 {
+		step := sc.steps[0]
+
+		if step.Research != "" {
+			if _, ok := s.State().Research[step.Research]; !ok {
+				if s.StartResearch(step.Research) != nil {
+					return nil
+				}
+			}
+
+			sc.steps = sc.steps[1:]
+
+			continue
+		}
+
+		if s.FreeSlots() <= 0 || s.StartBuilding(step.Building) != nil {
+			return nil
+		}
+
+		sc.started++
+		if sc.started >= step.Amount {
+			sc.steps = sc.steps[1:]
+			sc.started = 0
+		}
+	}
+
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
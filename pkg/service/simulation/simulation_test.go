@@ -0,0 +1,142 @@
+package simulation
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/pkg/service/registry"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+const testResources = `---
+kind: Resource
+name: Wood
+starting_amount: 10
+cap_formula: |
+  return 1000
+---
+kind: Resource
+name: Population
+starting_amount: 2
+`
+
+const testBuildings = `---
+kind: Building
+name: Woodcutter
+build_time: 1m
+workers_maximum: 1
+cost:
+  - resource: Wood
+    amount: 10
+    permanent: true
+generates:
+  - name: Wood
+    amount: 1
+    tick_length: 10s
+`
+
+// setConfig changes a setting until the end of the test
+func setConfig(t *testing.T, key string, value interface{}) {
+	previous := viper.Get(key)
+	viper.Set(key, value)
+	t.Cleanup(func() { viper.Set(key, previous) })
+}
+
+func TestRun(t *testing.T) {
+	setConfig(t, config.Registry_Remote_Kind, config.RegistryMemory)
+	setConfig(t, config.Inventory_Build_Slots, 1)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "resources.yaml"), []byte(testResources), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "buildings.yaml"), []byte(testBuildings), 0o600))
+	assert.NoError(t, registry.Load(dir))
+
+	result, err := Run(time.Now(), Options{
+		Duration: 10 * time.Minute,
+		Interval: time.Minute,
+		Script:   []Step{{Building: "Woodcutter", Amount: 1}}, // nolint:exhaustruct
+	})
+	assert.NoError(t, err)
+
+	if assert.Len(t, result.Samples, 11) {
+		assert.Equal(t, 0, result.Samples[1].Resources["Wood"])
+		assert.Equal(t, 1, result.Samples[1].Buildings["Woodcutter"])
+
+		// The woodcutter is staffed on completion and produces for the remaining 9 minutes
+		last := result.Samples[10]
+		assert.Equal(t, int64(600), last.Seconds)
+		assert.Equal(t, 54, last.Resources["Wood"])
+		assert.Equal(t, 1, last.Resources["Population"])
+	}
+
+	assert.Equal(t, []Milestone{{Kind: "building", Name: "Woodcutter", Seconds: 60}}, result.Milestones)
+
+	buf := bytes.NewBufferString("")
+	assert.NoError(t, WriteCSV(buf, result))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, "seconds,Population,Wood,building:Woodcutter", lines[0])
+	assert.Equal(t, "600,1,54,1", lines[len(lines)-1])
+
+	_, err = Run(time.Now(), Options{
+		Duration: time.Minute,
+		Interval: time.Minute,
+		Script:   []Step{{Building: "Sawmill", Amount: 1}}, // nolint:exhaustruct
+	})
+	assert.EqualError(t, err, `step 1: unknown building "Sawmill"`)
+}
+
+const testStorage = `---
+kind: Resource
+name: Wood
+starting_amount: 20
+cap_formula: |
+  return 20+buildings.shed*100
+---
+kind: Resource
+name: Population
+starting_amount: 1
+`
+
+const testShed = `---
+kind: Building
+name: Shed
+build_time: 5m
+cost:
+  - resource: Wood
+    amount: 10
+    permanent: true
+`
+
+func TestRunCaps(t *testing.T) {
+	setConfig(t, config.Registry_Remote_Kind, config.RegistryMemory)
+	setConfig(t, config.Inventory_Build_Slots, 1)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "resources.yaml"), []byte(testStorage), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "buildings.yaml"), []byte(testBuildings+testShed), 0o600))
+	assert.NoError(t, registry.Load(dir))
+
+	result, err := Run(time.Now(), Options{
+		Duration: 10 * time.Minute,
+		Interval: time.Minute,
+		Script:   []Step{{Building: "Woodcutter", Amount: 1}, {Building: "Shed", Amount: 1}}, // nolint:exhaustruct
+	})
+	assert.NoError(t, err)
+
+	if assert.Len(t, result.Samples, 11) {
+		// While the shed was built, the Wood produced stopped at the cap from before the shed, with
+		// the cost of the shed reserved
+		assert.Equal(t, 10, result.Samples[6].Resources["Wood"])
+		assert.Equal(t, 1, result.Samples[6].Buildings["Shed"])
+
+		// Only the 24 ticks after the shed was completed add to it
+		assert.Equal(t, 34, result.Samples[10].Resources["Wood"])
+	}
+}
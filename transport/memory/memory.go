@@ -0,0 +1,306 @@
+package memory
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrInvalidHandler = errors.New("handler has to be a func(*T) or a func(subject, reply string, *T) with *T a protobuf message")
+	ErrClosed         = errors.New("subscription is closed")
+	ErrTimeout        = errors.New("request timed out")
+)
+
+var messageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
+// Transport delivers messages between subscribers of the same process. Subjects can use the
+// NATS wildcards, * for one token and > for the rest of the subject. Every subscription has its
+// own goroutine, so handlers run in publish order without blocking the publisher.
+type Transport struct {
+	mx            sync.RWMutex
+	subscriptions map[*Subscription]struct{}
+
+	pendingMx sync.Mutex
+	flushed   *sync.Cond
+	pending   int
+}
+
+func New() *Transport {
+	t := &Transport{ // nolint:exhaustruct
+		subscriptions: make(map[*Subscription]struct{}),
+	}
+	t.flushed = sync.NewCond(&t.pendingMx)
+
+	return t
+}
+
+type Subscription struct {
+	transport *Transport
+	subject   string
+	handler   reflect.Value
+	argType   reflect.Type
+	withReply bool
+
+	mx     sync.Mutex
+	queue  []envelope
+	wake   chan struct{}
+	closed bool
+}
+
+type envelope struct {
+	subject string
+	reply   string
+	data    []byte
+}
+
+func (t *Transport) Publish(subject string, msg proto.Message) error {
+	return t.publish(subject, "", msg)
+}
+
+func (t *Transport) Subscribe(subject string, handler interface{}) (*Subscription, error) {
+	h := reflect.ValueOf(handler)
+	if h.Kind() != reflect.Func || h.Type().NumOut() != 0 {
+		return nil, ErrInvalidHandler
+	}
+
+	sub := &Subscription{ // nolint:exhaustruct
+		transport: t,
+		subject:   subject,
+		handler:   h,
+		queue:     make([]envelope, 0),
+		wake:      make(chan struct{}, 1),
+	}
+
+	switch h.Type().NumIn() {
+	case 1:
+		sub.argType = h.Type().In(0)
+	case 3:
+		if h.Type().In(0).Kind() != reflect.String || h.Type().In(1).Kind() != reflect.String {
+			return nil, ErrInvalidHandler
+		}
+
+		sub.argType = h.Type().In(2)
+		sub.withReply = true
+	default:
+		return nil, ErrInvalidHandler
+	}
+
+	if sub.argType.Kind() != reflect.Pointer || !sub.argType.Implements(messageType) {
+		return nil, ErrInvalidHandler
+	}
+
+	t.mx.Lock()
+	t.subscriptions[sub] = struct{}{}
+	t.mx.Unlock()
+
+	go sub.run()
+
+	return sub, nil
+}
+
+// Request publishes req with a reply subject and waits for the first reply
+func (t *Transport) Request(subject string, req proto.Message, res proto.Message, timeout time.Duration) error {
+	inbox := fmt.Sprintf("_INBOX.%s", uuid.New().String())
+	replies := make(chan []byte, 1)
+
+	sub := &Subscription{ // nolint:exhaustruct
+		transport: t,
+		subject:   inbox,
+		queue:     make([]envelope, 0),
+		wake:      make(chan struct{}, 1),
+	}
+
+	t.mx.Lock()
+	t.subscriptions[sub] = struct{}{}
+	t.mx.Unlock()
+
+	defer sub.Unsubscribe() // nolint:errcheck
+
+	go func() {
+		for {
+			env, ok := sub.next()
+			if !ok {
+				return
+			}
+
+			select {
+			case replies <- env.data:
+			default:
+			}
+
+			t.addPending(-1)
+		}
+	}()
+
+	if err := t.publish(subject, inbox, req); err != nil {
+		return err
+	}
+
+	select {
+	case data := <-replies:
+		return proto.Unmarshal(data, res)
+	case <-time.After(timeout):
+		return ErrTimeout
+	}
+}
+
+// Flush waits until every message published so far was handled, including the messages
+// the handlers published in the meantime
+func (t *Transport) Flush() {
+	t.pendingMx.Lock()
+	defer t.pendingMx.Unlock()
+
+	for t.pending > 0 {
+		t.flushed.Wait()
+	}
+}
+
+func (t *Transport) addPending(delta int) {
+	t.pendingMx.Lock()
+	defer t.pendingMx.Unlock()
+
+	t.pending += delta
+	if t.pending == 0 {
+		t.flushed.Broadcast()
+	}
+}
+
+func (t *Transport) publish(subject, reply string, msg proto.Message) error {
+	// Subscribers get their own copy of the message, like they would over the wire
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	for sub := range t.subscriptions {
+		if !matches(sub.subject, subject) {
+			continue
+		}
+
+		sub.push(envelope{
+			subject: subject,
+			reply:   reply,
+			data:    data,
+		})
+	}
+
+	return nil
+}
+
+func (s *Subscription) Unsubscribe() error {
+	s.transport.mx.Lock()
+	delete(s.transport.subscriptions, s)
+	s.transport.mx.Unlock()
+
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	s.closed = true
+
+	// Dropped messages won't be handled, so they aren't pending anymore
+	s.transport.addPending(-len(s.queue))
+
+	s.queue = nil
+
+	close(s.wake)
+
+	return nil
+}
+
+func (s *Subscription) push(env envelope) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	s.transport.addPending(1)
+	s.queue = append(s.queue, env)
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// next blocks until there's a message to handle, it returns false once the subscription is closed
+func (s *Subscription) next() (envelope, bool) {
+	for {
+		s.mx.Lock()
+		if len(s.queue) > 0 {
+			env := s.queue[0]
+			s.queue = s.queue[1:]
+			s.mx.Unlock()
+
+			return env, true
+		}
+		s.mx.Unlock()
+
+		if _, ok := <-s.wake; !ok {
+			return envelope{}, false // nolint:exhaustruct
+		}
+	}
+}
+
+func (s *Subscription) run() {
+	for {
+		env, ok := s.next()
+		if !ok {
+			return
+		}
+
+		s.handle(env)
+		s.transport.addPending(-1)
+	}
+}
+
+func (s *Subscription) handle(env envelope) {
+	msg := reflect.New(s.argType.Elem())
+	if err := proto.Unmarshal(env.data, msg.Interface().(proto.Message)); err != nil { // nolint:forcetypeassert
+		return
+	}
+
+	if s.withReply {
+		s.handler.Call([]reflect.Value{reflect.ValueOf(env.subject), reflect.ValueOf(env.reply), msg})
+		return
+	}
+
+	s.handler.Call([]reflect.Value{msg})
+}
+
+// matches reports whether a subject matches a subscription pattern with NATS wildcards
+func matches(pattern, subject string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+
+		if i >= len(subjectTokens) {
+			return false
+		}
+
+		if token != "*" && token != subjectTokens[i] {
+			return false
+		}
+	}
+
+	return len(patternTokens) == len(subjectTokens)
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/protobuf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishSubscribe(t *testing.T) {
+	tr := New()
+
+	received := make([]string, 0)
+	sub, err := tr.Subscribe("timer-status", func(msg *protobuf.TimerStopped) {
+		received = append(received, msg.TimerID)
+	})
+	assert.NoError(t, err)
+
+	for _, id := range []string{"a", "b", "c"} {
+		assert.NoError(t, tr.Publish("timer-status", &protobuf.TimerStopped{TimerID: id})) // nolint:exhaustruct
+	}
+
+	assert.NoError(t, tr.Publish("other", &protobuf.TimerStopped{TimerID: "d"})) // nolint:exhaustruct
+
+	tr.Flush()
+	assert.Equal(t, []string{"a", "b", "c"}, received)
+
+	assert.NoError(t, sub.Unsubscribe())
+	assert.ErrorIs(t, sub.Unsubscribe(), ErrClosed)
+
+	assert.NoError(t, tr.Publish("timer-status", &protobuf.TimerStopped{TimerID: "e"})) // nolint:exhaustruct
+	tr.Flush()
+	assert.Equal(t, []string{"a", "b", "c"}, received)
+}
+
+func TestInvalidHandler(t *testing.T) {
+	tr := New()
+
+	for _, handler := range []interface{}{
+		"not a func",
+		func(string) {},
+		func(*protobuf.TimerStopped) error { return nil },
+		func(int, string, *protobuf.TimerStopped) {},
+	} {
+		_, err := tr.Subscribe("subject", handler)
+		assert.ErrorIs(t, err, ErrInvalidHandler)
+	}
+}
+
+func TestRequest(t *testing.T) {
+	tr := New()
+
+	_, err := tr.Subscribe("describe", func(subject, reply string, msg *protobuf.DescribeTimerRequest) {
+		assert.NoError(t, tr.Publish(reply, &protobuf.DescribeTimerResponse{ // nolint:exhaustruct
+			Status: protobuf.Status_OK,
+			Error:  msg.TraceID,
+		}))
+	})
+	assert.NoError(t, err)
+
+	res := &protobuf.DescribeTimerResponse{} // nolint:exhaustruct
+
+	err = tr.Request("describe", &protobuf.DescribeTimerRequest{TraceID: "trace"}, res, time.Second) // nolint:exhaustruct
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.Status_OK, res.Status)
+	assert.Equal(t, "trace", res.Error)
+
+	err = tr.Request("nobody", &protobuf.DescribeTimerRequest{}, res, 10*time.Millisecond) // nolint:exhaustruct
+	assert.ErrorIs(t, err, ErrTimeout)
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		subject  string
+		expected bool
+	}{
+		{"timer-status", "timer-status", true},
+		{"timer-status", "timer-status.x", false},
+		{"callbacks.*.building", "callbacks.inv.building", true},
+		{"callbacks.*.building", "callbacks.inv.resource", false},
+		{"callbacks.inv.>", "callbacks.inv.building", true},
+		{"callbacks.inv.>", "callbacks.inv", false},
+		{"callbacks.>", "callbacks.inv.building", true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, matches(tt.pattern, tt.subject), "%s ~ %s", tt.pattern, tt.subject)
+	}
+}
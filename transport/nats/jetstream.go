@@ -0,0 +1,140 @@
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/proto"
+)
+
+// callbackPrefix is the subject root of the timer callback stream
+const callbackPrefix = "callbacks"
+
+var (
+	js   nats.JetStreamContext
+	jsMx sync.Mutex
+)
+
+// JetStreamEnabled reports whether timer callbacks are delivered through JetStream, which needs
+// the NATS transport
+func JetStreamEnabled() bool {
+	return viper.GetString(config.Transport_Kind) == config.TransportNATS && viper.GetBool(config.NATS_JetStream)
+}
+
+// CallbackSubject returns the subject an inventory receives one kind of timer callbacks on.
+// With JetStream every callback subject of an inventory sits under its own token so a
+// single durable consumer can filter them.
+func CallbackSubject(identity, kind string) string {
+	if JetStreamEnabled() {
+		return fmt.Sprintf("%s.%s.%s", callbackPrefix, identity, kind)
+	}
+
+	return fmt.Sprintf("%s-%s-callbacks", identity, kind)
+}
+
+// GetJetStream returns the JetStream context, setting up the callback stream the first time. A
+// failed setup is tried again on the next call.
+func GetJetStream() (nats.JetStreamContext, error) {
+	jsMx.Lock()
+	defer jsMx.Unlock()
+
+	if js == nil {
+		conn, err := GetConnection()
+		if err != nil {
+			return nil, err
+		}
+
+		ctx, err := conn.Conn.JetStream()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := ensureStream(ctx); err != nil {
+			return nil, err
+		}
+
+		js = ctx
+	}
+
+	return js, nil
+}
+
+func streamName() string {
+	return viper.GetString(config.NATS_JetStream_Stream)
+}
+
+func ensureStream(ctx nats.JetStreamContext) error {
+	name := streamName()
+
+	_, err := ctx.StreamInfo(name)
+	if err == nil {
+		return nil
+	}
+
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return err
+	}
+
+	slog.Info("creating timer callback stream", "stream", name)
+
+	// Acked callbacks are applied, there's no reason to keep them around
+	_, err = ctx.AddStream(&nats.StreamConfig{ // nolint:exhaustruct
+		Name:      name,
+		Subjects:  []string{fmt.Sprintf("%s.>", callbackPrefix)},
+		Retention: nats.WorkQueuePolicy,
+		Storage:   nats.FileStorage,
+	})
+
+	return err
+}
+
+// PublishDurable stores a message in the callback stream. The server drops messages with an id
+// it has already seen within its duplicate window.
+func PublishDurable(subject string, msg proto.Message, id string) error {
+	ctx, err := GetJetStream()
+	if err != nil {
+		return err
+	}
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctx.Publish(subject, data, nats.MsgId(id))
+
+	return err
+}
+
+// SubscribeDurable binds to the durable consumer of an inventory, creating it the first time.
+// Unsubscribing keeps the consumer, so callbacks published while the inventory is inactive
+// are delivered once it subscribes again. The handler has to ack the messages it applied.
+func SubscribeDurable(identity string, handler nats.MsgHandler) (*nats.Subscription, error) {
+	ctx, err := GetJetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	durable := fmt.Sprintf("inventory-%s", identity)
+
+	if _, err := ctx.ConsumerInfo(streamName(), durable); errors.Is(err, nats.ErrConsumerNotFound) {
+		if _, err := ctx.AddConsumer(streamName(), &nats.ConsumerConfig{ // nolint:exhaustruct
+			Durable:        durable,
+			FilterSubject:  fmt.Sprintf("%s.%s.>", callbackPrefix, identity),
+			AckPolicy:      nats.AckExplicitPolicy,
+			DeliverPolicy:  nats.DeliverAllPolicy,
+			DeliverSubject: nats.NewInbox(),
+		}); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return ctx.Subscribe("", handler, nats.Bind(streamName(), durable), nats.ManualAck())
+}
@@ -0,0 +1,32 @@
+package nats
+
+import (
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+// Transport sends protobuf messages over a NATS encoded connection
+type Transport struct {
+	conn *nats.EncodedConn
+}
+
+func NewTransport(conn *nats.EncodedConn) *Transport {
+	return &Transport{
+		conn: conn,
+	}
+}
+
+func (t *Transport) Publish(subject string, msg proto.Message) error {
+	return t.conn.Publish(subject, msg)
+}
+
+// Subscribe returns a *nats.Subscription
+func (t *Transport) Subscribe(subject string, handler interface{}) (*nats.Subscription, error) {
+	return t.conn.Subscribe(subject, handler)
+}
+
+func (t *Transport) Request(subject string, req proto.Message, res proto.Message, timeout time.Duration) error {
+	return t.conn.Request(subject, req, res, timeout)
+}
@@ -0,0 +1,101 @@
+package transport
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xa1-red/empires-of-avalon/config"
+	"github.com/0xa1-red/empires-of-avalon/transport/memory"
+	"github.com/0xa1-red/empires-of-avalon/transport/nats"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/proto"
+)
+
+// Handler is a func(*T) or a func(subject, reply string, *T) where *T is a protobuf message.
+// Messages are decoded into a new T before the handler is called.
+type Handler interface{}
+
+// Transport is the messaging layer between the grains
+type Transport interface {
+	Publish(subject string, msg proto.Message) error
+	Subscribe(subject string, handler Handler) (Subscription, error)
+	// Request publishes req and decodes the first reply into res
+	Request(subject string, req proto.Message, res proto.Message, timeout time.Duration) error
+}
+
+type Subscription interface {
+	Unsubscribe() error
+}
+
+var (
+	mx        sync.Mutex
+	transport Transport
+)
+
+// Get returns the transport selected by the transport.kind setting, connecting on first use
+func Get() (Transport, error) {
+	mx.Lock()
+	defer mx.Unlock()
+
+	if transport != nil {
+		return transport, nil
+	}
+
+	switch kind := viper.GetString(config.Transport_Kind); kind {
+	case config.TransportNATS:
+		conn, err := nats.GetConnection()
+		if err != nil {
+			return nil, err
+		}
+
+		transport = natsTransport{nats.NewTransport(conn)}
+	case config.TransportMemory:
+		transport = memoryTransport{memory.New()}
+	default:
+		return nil, fmt.Errorf("unknown transport kind: %s", kind)
+	}
+
+	return transport, nil
+}
+
+// Memory wraps an in-process transport, so a test can Set it and still Flush it
+func Memory(m *memory.Transport) Transport {
+	return memoryTransport{m}
+}
+
+// Set replaces the transport, eg. with an in-memory one in tests
+func Set(t Transport) {
+	mx.Lock()
+	defer mx.Unlock()
+
+	transport = t
+}
+
+// The implementations return their own subscription types, these adapters turn them into Subscriptions
+
+type natsTransport struct {
+	*nats.Transport
+}
+
+func (t natsTransport) Subscribe(subject string, handler Handler) (Subscription, error) {
+	sub, err := t.Transport.Subscribe(subject, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+type memoryTransport struct {
+	*memory.Transport
+}
+
+func (t memoryTransport) Subscribe(subject string, handler Handler) (Subscription, error) {
+	sub, err := t.Transport.Subscribe(subject, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}